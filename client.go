@@ -9,14 +9,27 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
 	"sync"
+	"time"
+
+	"github.com/mailru/easyjson"
 )
 
 type client struct {
-	client     *http.Client
-	host       string
-	apiKey     string
-	bufferPool *sync.Pool
+	client      *http.Client
+	host        string
+	apiKey      string
+	bufferPool  *sync.Pool
+	retryPolicy *RetryPolicy
+	hostPool    *hostPool
+	middlewares []Middleware
+	metrics     MetricsRecorder
+	encoding    ContentEncoding
+	marshal     JSONMarshal
+	unmarshal   JSONUnmarshal
+	errorBodies errorBodyPolicy
+	poll        PollStrategy
 }
 
 type internalRequest struct {
@@ -24,15 +37,45 @@ type internalRequest struct {
 	method      string
 	contentType string
 
-	withRequest     interface{}
-	withResponse    interface{}
+	withRequest interface{}
+
+	// withResponse receives the decoded response body, an *io.ReadCloser receives the body
+	// itself, which the caller must close.
+	withResponse interface{}
+
 	withQueryParams map[string]string
+	withHeaders     http.Header
 
 	acceptedStatusCodes []int
 
+	// readOnly marks the requests that do not modify the database, a cluster can serve them
+	// from any of its healthy hosts.
+	readOnly bool
+
+	// requireIdempotencyGuard prevents the request from being retried unless its context
+	// carries an idempotency guard, see MarkIdempotent.
+	requireIdempotencyGuard bool
+
 	functionName string
 }
 
+// requestBody holds the encoded body of a request so that it can be sent again on retry.
+type requestBody struct {
+	buf    *bytes.Buffer
+	reader io.Reader
+	seeker io.Seeker
+	offset int64
+	opened bool
+
+	// encoding compresses the body while it is sent, compression is the one of the
+	// current attempt.
+	encoding    ContentEncoding
+	compression *compression
+
+	// sent counts the bytes sent over all the attempts.
+	sent int64
+}
+
 func newClient(cli *http.Client, host, apiKey string) *client {
 	return &client{
 		client: cli,
@@ -43,57 +86,228 @@ func newClient(cli *http.Client, host, apiKey string) *client {
 				return new(bytes.Buffer)
 			},
 		},
+		errorBodies: newErrorBodyPolicy(defaultErrorBodyLimit, false, nil),
 	}
 }
 
+// newClientWithOptions creates a client for host configured by opt.
+func newClientWithOptions(host string, opt *meiliOpt) *client {
+	cli := newClient(opt.client, host, opt.apiKey)
+	cli.retryPolicy = opt.retryPolicy
+	cli.middlewares = opt.middlewares
+	cli.metrics = opt.metrics
+	cli.encoding = opt.contentEncoding
+	cli.marshal = opt.marshal
+	cli.unmarshal = opt.unmarshal
+	cli.errorBodies = newErrorBodyPolicy(opt.errorBodyLimit, opt.excludeErrorBodies, opt.errorRedactedFields)
+	cli.poll = opt.pollStrategy
+	return cli
+}
+
 func (c *client) executeRequest(ctx context.Context, req *internalRequest) error {
+	if len(c.middlewares) == 0 {
+		_, err := c.handleRequest(ctx, req)
+		return err
+	}
+
+	var handler Handler = func(ctx context.Context, request *Request) (*Response, error) {
+		request.applyTo(req)
+		return c.handleRequest(ctx, req)
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		handler = c.middlewares[i](handler)
+	}
+
+	_, err := handler(ctx, newRequest(req))
+	return err
+}
+
+// handleRequest sends req and decodes its response, the returned Response is nil when no
+// response was received.
+func (c *client) handleRequest(ctx context.Context, req *internalRequest) (response *Response, err error) {
+	var metrics *RequestMetrics
+	if c.metrics != nil {
+		metrics = &RequestMetrics{
+			Function: req.functionName,
+			Method:   req.method,
+		}
+		start := time.Now()
+		defer func() {
+			metrics.Duration = time.Since(start)
+			if response != nil {
+				metrics.StatusCode = response.StatusCode
+			}
+			metrics.observeError(err)
+			c.metrics.ObserveRequest(*metrics)
+		}()
+	}
+
 	internalError := &Error{
 		Endpoint:         req.endpoint,
 		Method:           req.method,
 		Function:         req.functionName,
 		RequestToString:  "empty request",
 		ResponseToString: "empty response",
-		MeilisearchApiError: meilisearchApiError{
+		MeilisearchApiError: APIError{
 			Message: "empty meilisearch message",
 		},
 		StatusCodeExpected: req.acceptedStatusCodes,
 	}
 
-	resp, err := c.sendRequest(ctx, req, internalError)
+	body, err := c.encodeRequestBody(req, internalError)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if body != nil {
+		body.encoding = c.contentEncoding(ctx)
+		if !body.encoding.valid() {
+			return nil, ErrInvalidContentEncoding
+		}
+	}
+	defer func() {
+		if body != nil && body.buf != nil {
+			c.bufferPool.Put(body.buf)
+		}
+	}()
+
+	resp, err := c.sendRequest(ctx, req, body, internalError)
+	if metrics != nil && body != nil {
+		metrics.BytesSent = body.sent
+	}
+	if err != nil {
+		return nil, err
 	}
 
+	// streamed is set once the body is handed over to the caller, who closes it
+	streamed := false
 	defer func() {
-		_ = resp.Body.Close()
+		if !streamed {
+			_ = resp.Body.Close()
+		}
 	}()
 
 	internalError.StatusCode = resp.StatusCode
+	response = &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+	}
+	if resp.Request != nil {
+		response.RequestHeader = resp.Request.Header
+	}
+
+	var received int64
+	respBody := &countingReader{reader: resp.Body, count: &received}
+	defer func() {
+		// Drain what the decoder left so that the connection can be reused
+		if !streamed {
+			_, _ = io.Copy(io.Discard, respBody)
+		}
+		if metrics != nil {
+			metrics.BytesReceived = received
+		}
+	}()
 
-	b, err := io.ReadAll(resp.Body)
+	err = c.handleStatusCode(req, resp.StatusCode, respBody, internalError)
 	if err != nil {
-		return err
+		return response, err
 	}
 
-	err = c.handleStatusCode(req, resp.StatusCode, b, internalError)
-	if err != nil {
-		return err
+	if stream, ok := req.withResponse.(*io.ReadCloser); ok {
+		*stream = resp.Body
+		streamed = true
+		response.Result = req.withResponse
+		return response, nil
 	}
 
-	err = c.handleResponse(req, b, internalError)
+	err = c.handleResponse(req, respBody, internalError)
 	if err != nil {
-		return err
+		return response, err
 	}
-	return nil
+	response.Result = req.withResponse
+	return response, nil
 }
 
 func (c *client) sendRequest(
 	ctx context.Context,
 	req *internalRequest,
+	body *requestBody,
 	internalError *Error,
 ) (*http.Response, error) {
+	failovers := 0
+	for attempt := 1; ; attempt++ {
+		host := c.host
+		if c.hostPool != nil {
+			host = c.hostPool.pick(req.readOnly)
+		}
 
-	apiURL, err := url.Parse(c.host + req.endpoint)
+		apiURL, err := requestURL(host, req)
+		if err != nil {
+			return nil, err
+		}
+
+		reader, err := body.open()
+		if err != nil {
+			return nil, internalError.WithErrCode(MeilisearchCommunicationError, err)
+		}
+
+		// Create the HTTP request
+		request, err := http.NewRequestWithContext(ctx, req.method, apiURL.String(), reader)
+		if err != nil {
+			body.release()
+			return nil, fmt.Errorf("unable to create request: %w", err)
+		}
+		if length, ok := body.contentLength(); ok {
+			request.ContentLength = length
+		}
+
+		// adding request headers
+		if req.contentType != "" {
+			request.Header.Set("Content-Type", req.contentType)
+		}
+		if body != nil && body.encoding != NoEncoding {
+			request.Header.Set("Content-Encoding", string(body.encoding))
+		}
+		if c.apiKey != "" {
+			request.Header.Set("Authorization", "Bearer "+c.apiKey)
+		}
+
+		request.Header.Set("User-Agent", GetQualifiedVersion())
+		for key, values := range req.withHeaders {
+			request.Header[key] = values
+		}
+
+		resp, err := c.client.Do(request)
+		body.release()
+		if err != nil && c.hostPool != nil && ctx.Err() == nil {
+			c.hostPool.markDown(host)
+			if c.shouldFailover(req, body, failovers) {
+				// Another host can serve the request right away
+				failovers++
+				attempt--
+				continue
+			}
+		}
+		if !c.shouldRetry(ctx, req, body, attempt, resp, err) {
+			if err != nil {
+				return nil, communicationError(internalError, err)
+			}
+			return resp, nil
+		}
+
+		wait := c.retryPolicy.backoff(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, communicationError(internalError, err)
+		}
+	}
+}
+
+// requestURL builds the URL of req on host.
+func requestURL(host string, req *internalRequest) (*url.URL, error) {
+	apiURL, err := url.Parse(host + req.endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse url: %w", err)
 	}
@@ -106,87 +320,216 @@ func (c *client) sendRequest(
 
 		apiURL.RawQuery = query.Encode()
 	}
+	return apiURL, nil
+}
 
-	// Create request body
-	var body io.Reader = nil
-	if req.withRequest != nil {
-		if req.method == http.MethodGet || req.method == http.MethodHead {
-			return nil, ErrInvalidRequestMethod
-		}
-		if req.contentType == "" {
-			return nil, ErrRequestBodyWithoutContentType
-		}
+// encodeRequestBody converts req.withRequest into a requestBody, nil is returned when the
+// request has no body.
+func (c *client) encodeRequestBody(req *internalRequest, internalError *Error) (*requestBody, error) {
+	if req.withRequest == nil {
+		return nil, nil
+	}
+	if req.method == http.MethodGet || req.method == http.MethodHead {
+		return nil, ErrInvalidRequestMethod
+	}
+	if req.contentType == "" {
+		return nil, ErrRequestBodyWithoutContentType
+	}
 
-		rawRequest := req.withRequest
-		if b, ok := rawRequest.([]byte); ok {
-			// If the request body is already a []byte then use it directly
-			buf := c.bufferPool.Get().(*bytes.Buffer)
-			buf.Reset()
-			buf.Write(b)
-			body = buf
-		} else if reader, ok := rawRequest.(io.Reader); ok {
-			// If the request body is an io.Reader then stream it directly
-			body = reader
-		} else {
-			// Otherwise convert it to JSON
-			var (
-				data []byte
-				err  error
-			)
-			if marshaler, ok := rawRequest.(json.Marshaler); ok {
-				data, err = marshaler.MarshalJSON()
-				if err != nil {
-					return nil, internalError.WithErrCode(ErrCodeMarshalRequest, fmt.Errorf("failed to marshal with MarshalJSON: %w", err))
-				}
-				if data == nil {
-					return nil, internalError.WithErrCode(ErrCodeMarshalRequest, errors.New("MarshalJSON returned nil data"))
-				}
-			} else {
-				data, err = json.Marshal(rawRequest)
-				if err != nil {
-					return nil, internalError.WithErrCode(ErrCodeMarshalRequest, fmt.Errorf("failed to marshal with json.Marshal: %w", err))
-				}
+	rawRequest := req.withRequest
+	if b, ok := rawRequest.([]byte); ok {
+		// If the request body is already a []byte then use it directly
+		buf := c.bufferPool.Get().(*bytes.Buffer)
+		buf.Reset()
+		buf.Write(b)
+		return &requestBody{buf: buf}, nil
+	} else if reader, ok := rawRequest.(io.Reader); ok {
+		// If the request body is an io.Reader then stream it directly, it can only be sent
+		// again when it is also an io.Seeker
+		body := &requestBody{reader: reader}
+		if seeker, ok := reader.(io.Seeker); ok {
+			offset, err := seeker.Seek(0, io.SeekCurrent)
+			if err == nil {
+				body.seeker = seeker
+				body.offset = offset
 			}
-			buf := c.bufferPool.Get().(*bytes.Buffer)
-			buf.Reset()
-			buf.Write(data)
-			body = buf
 		}
+		return body, nil
 	}
 
-	// Create the HTTP request
-	request, err := http.NewRequestWithContext(ctx, req.method, apiURL.String(), body)
+	// Otherwise convert it to JSON
+	var (
+		data []byte
+		err  error
+	)
+	if c.marshal != nil {
+		data, err = c.marshal(codecValue(rawRequest))
+		if err != nil {
+			return nil, internalError.WithErrCode(ErrCodeMarshalRequest, fmt.Errorf("failed to marshal with custom marshaler: %w", err))
+		}
+	} else if marshaler, ok := rawRequest.(json.Marshaler); ok {
+		data, err = marshaler.MarshalJSON()
+		if err != nil {
+			return nil, internalError.WithErrCode(ErrCodeMarshalRequest, fmt.Errorf("failed to marshal with MarshalJSON: %w", err))
+		}
+		if data == nil {
+			return nil, internalError.WithErrCode(ErrCodeMarshalRequest, errors.New("MarshalJSON returned nil data"))
+		}
+	} else {
+		data, err = json.Marshal(rawRequest)
+		if err != nil {
+			return nil, internalError.WithErrCode(ErrCodeMarshalRequest, fmt.Errorf("failed to marshal with json.Marshal: %w", err))
+		}
+	}
+	buf := c.bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	buf.Write(data)
+	return &requestBody{buf: buf}, nil
+}
+
+// open returns a reader over the whole body, rewinding it when it was already sent.
+func (b *requestBody) open() (io.Reader, error) {
+	if b == nil {
+		return nil, nil
+	}
+	if b.encoding != NoEncoding {
+		source, err := b.source()
+		if err != nil {
+			return nil, err
+		}
+		b.compression = compress(source, b.encoding, &b.sent)
+		return b.compression.reader, nil
+	}
+	if b.buf != nil {
+		b.sent += int64(b.buf.Len())
+		return bytes.NewReader(b.buf.Bytes()), nil
+	}
+	source, err := b.source()
 	if err != nil {
-		return nil, fmt.Errorf("unable to create request: %w", err)
+		return nil, err
 	}
+	return &countingReader{reader: source, count: &b.sent}, nil
+}
+
+// source returns a reader over the body as given by the caller.
+func (b *requestBody) source() (io.Reader, error) {
+	if b.buf != nil {
+		return bytes.NewReader(b.buf.Bytes()), nil
+	}
+	if b.opened && b.seeker != nil {
+		if _, err := b.seeker.Seek(b.offset, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("unable to rewind request body: %w", err)
+		}
+	}
+	b.opened = true
+	return b.reader, nil
+}
 
-	// adding request headers
-	if req.contentType != "" {
-		request.Header.Set("Content-Type", req.contentType)
+// release stops the compression of the current attempt, if any, so that the body is no longer
+// read once the attempt is over.
+func (b *requestBody) release() {
+	if b == nil || b.compression == nil {
+		return
 	}
-	if c.apiKey != "" {
-		request.Header.Set("Authorization", "Bearer "+c.apiKey)
+	b.compression.close()
+	b.compression = nil
+}
+
+// contentLength returns the length of the body when it is known before reading it.
+func (b *requestBody) contentLength() (int64, bool) {
+	if b == nil || b.buf != nil || b.encoding != NoEncoding {
+		return 0, false
 	}
+	if lener, ok := b.reader.(interface{ Len() int }); ok {
+		return int64(lener.Len()), true
+	}
+	return 0, false
+}
 
-	request.Header.Set("User-Agent", GetQualifiedVersion())
+// countingReader counts the bytes read from reader.
+type countingReader struct {
+	reader io.Reader
+	count  *int64
 
-	resp, err := c.client.Do(request)
+	// err is the first error other than io.EOF returned by reader.
+	err error
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	*r.count += int64(n)
+	if err != nil && err != io.EOF && r.err == nil {
+		r.err = err
+	}
+	return n, err
+}
+
+// replayable reports whether the body can be sent more than once.
+func (b *requestBody) replayable() bool {
+	return b == nil || b.buf != nil || b.seeker != nil
+}
+
+// shouldRetry reports whether the attempt that produced resp and err must be made again.
+func (c *client) shouldRetry(
+	ctx context.Context,
+	req *internalRequest,
+	body *requestBody,
+	attempt int,
+	resp *http.Response,
+	err error,
+) bool {
+	policy := c.retryPolicy
+	if policy == nil || attempt >= policy.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+	if !body.replayable() || !req.idempotent(ctx) {
+		return false
+	}
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
-			return nil, internalError.WithErrCode(MeilisearchTimeoutError, err)
+		return true
+	}
+	for _, acceptedCode := range req.acceptedStatusCodes {
+		if resp.StatusCode == acceptedCode {
+			return false
 		}
-		return nil, internalError.WithErrCode(MeilisearchCommunicationError, err)
 	}
+	return policy.retryableStatus(resp.StatusCode)
+}
 
-	if body != nil {
-		if buf, ok := body.(*bytes.Buffer); ok {
-			c.bufferPool.Put(buf)
-		}
+// shouldFailover reports whether a read request which could not reach its host can be sent
+// to another host of the cluster.
+func (c *client) shouldFailover(req *internalRequest, body *requestBody, failovers int) bool {
+	if !req.readOnly || !body.replayable() {
+		return false
+	}
+	return failovers < len(c.hostPool.hosts)-1 && c.hostPool.healthyCount() > 0
+}
+
+// idempotent reports whether sending the request twice has the same effect as sending it once.
+func (req *internalRequest) idempotent(ctx context.Context) bool {
+	if req.requireIdempotencyGuard {
+		return hasIdempotencyGuard(ctx)
+	}
+	if req.readOnly {
+		return true
+	}
+	switch req.method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
 	}
-	return resp, nil
+	return false
 }
 
-func (c *client) handleStatusCode(req *internalRequest, statusCode int, body []byte, internalError *Error) error {
+func communicationError(internalError *Error, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return internalError.WithErrCode(MeilisearchTimeoutError, err)
+	}
+	return internalError.WithErrCode(MeilisearchCommunicationError, err)
+}
+
+// handleStatusCode reads the error sent by Meilisearch when the status code is not one of the
+// accepted ones, the body is only read in that case.
+func (c *client) handleStatusCode(req *internalRequest, statusCode int, body io.Reader, internalError *Error) error {
 	if req.acceptedStatusCodes != nil {
 
 		// A successful status code is required so check if the response status code is in the
@@ -197,7 +540,12 @@ func (c *client) handleStatusCode(req *internalRequest, statusCode int, body []b
 			}
 		}
 
-		internalError.ErrorBody(body)
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return communicationError(internalError, err)
+		}
+		internalError.ErrorBody(b)
+		internalError.ResponseToString = c.errorBodies.dump(b)
 
 		if internalError.MeilisearchApiError.Code == "" {
 			return internalError.WithErrCode(MeilisearchApiErrorWithoutMessage)
@@ -208,21 +556,127 @@ func (c *client) handleStatusCode(req *internalRequest, statusCode int, body []b
 	return nil
 }
 
-func (c *client) handleResponse(req *internalRequest, body []byte, internalError *Error) (err error) {
-	if req.withResponse != nil {
-
-		internalError.ResponseToString = string(body)
+// handleResponse decodes the body into req.withResponse, straight from the body with encoding/json
+// and read whole first for a responseDecoder or a custom unmarshaler, which take bytes. The body
+// is only kept, up to the error body limit, when it cannot be decoded. The requests whose
+// response is an *io.ReadCloser hand the body over to the caller instead.
+func (c *client) handleResponse(req *internalRequest, body *countingReader, internalError *Error) error {
+	if req.withResponse == nil {
+		return nil
+	}
 
-		var err error
-		if resp, ok := req.withResponse.(json.Unmarshaler); ok {
-			err = resp.UnmarshalJSON(body)
-			req.withResponse = resp
+	decoder, isDecoder := req.withResponse.(responseDecoder)
+	if isDecoder || c.unmarshal != nil {
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return communicationError(internalError, err)
+		}
+		if isDecoder {
+			err = decoder.decodeJSON(b, c.unmarshalJSON)
 		} else {
-			err = json.Unmarshal(body, req.withResponse)
+			err = c.unmarshalJSON(b, req.withResponse)
 		}
 		if err != nil {
+			internalError.ResponseToString = c.errorBodies.dump(b)
 			return internalError.WithErrCode(ErrCodeResponseUnmarshalBody, err)
 		}
+		return nil
+	}
+
+	dec := json.NewDecoder(body)
+	value := &failedValue{v: req.withResponse}
+	if err := dec.Decode(value); err != nil {
+		if body.err != nil {
+			return communicationError(internalError, body.err)
+		}
+		if value.data != nil {
+			internalError.ResponseToString = c.errorBodies.dump(value.data)
+		} else {
+			// the body is not valid JSON, the decoder buffered all of it that was read
+			buffered, _ := io.ReadAll(dec.Buffered())
+			internalError.ResponseToString = c.errorBodies.dump(buffered)
+		}
+		return internalError.WithErrCode(ErrCodeResponseUnmarshalBody, err)
+	}
+	return nil
+}
+
+// failedValue decodes a JSON value into v, keeping the value in data when it cannot be decoded
+// into v. Given to a json.Decoder, data is the buffer of the decoder rather than a copy.
+type failedValue struct {
+	v    interface{}
+	data []byte
+}
+
+func (f *failedValue) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, f.v); err != nil {
+		f.data = data
+		return err
 	}
 	return nil
 }
+
+// responseDecoder is implemented by the responses decoded in several steps, each step decoding
+// the body with unmarshal.
+type responseDecoder interface {
+	decodeJSON(data []byte, unmarshal JSONUnmarshal) error
+}
+
+// unmarshalJSON decodes data into v with the JSON unmarshaler of the client, encoding/json when
+// none is configured.
+func (c *client) unmarshalJSON(data []byte, v interface{}) error {
+	if c.unmarshal != nil {
+		return c.unmarshal(data, codecValue(v))
+	}
+	return json.Unmarshal(data, v)
+}
+
+var (
+	easyjsonMarshalerType   = reflect.TypeOf((*easyjson.Marshaler)(nil)).Elem()
+	easyjsonUnmarshalerType = reflect.TypeOf((*easyjson.Unmarshaler)(nil)).Elem()
+
+	// codecTypes caches the type given to the custom JSON codec for a pointer type, nil when the
+	// value is given as is.
+	codecTypes sync.Map
+)
+
+// codecValue returns v as given to the custom JSON codec. The types of this package implement
+// json.Marshaler and json.Unmarshaler with easyjson, a codec would call these methods rather than
+// doing the work itself. A pointer to one of them is thus converted to a pointer to a struct with
+// the same fields and no method, pointing to the same value. The fields whose type is one of the
+// package keep their methods.
+func codecValue(v interface{}) interface{} {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return v
+	}
+
+	view, ok := codecTypes.Load(value.Type())
+	if !ok {
+		view, _ = codecTypes.LoadOrStore(value.Type(), codecType(value.Type()))
+	}
+	viewType, _ := view.(reflect.Type)
+	if viewType == nil {
+		return v
+	}
+	return value.Convert(viewType).Interface()
+}
+
+// codecType returns the pointer type to a struct with the fields of the easyjson struct pointed
+// by t, nil when t is not such a pointer.
+func codecType(t reflect.Type) reflect.Type {
+	if t.Elem().Kind() != reflect.Struct || !(t.Implements(easyjsonMarshalerType) || t.Implements(easyjsonUnmarshalerType)) {
+		return nil
+	}
+
+	elem := t.Elem()
+	fields := make([]reflect.StructField, elem.NumField())
+	for i := range fields {
+		field := elem.Field(i)
+		if field.Anonymous || field.PkgPath != "" {
+			return nil
+		}
+		fields[i] = reflect.StructField{Name: field.Name, Type: field.Type, Tag: field.Tag}
+	}
+	return reflect.PtrTo(reflect.StructOf(fields))
+}
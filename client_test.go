@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/stretchr/testify/require"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -222,3 +223,112 @@ func (m mockJsonMarshaller) MarshalJSON() ([]byte, error) {
 		Alias: Alias(m),
 	})
 }
+
+func TestExecuteRequest_DecodeResponse(t *testing.T) {
+	largeHits := `{"results":[` + strings.Repeat(`{"id":1},`, 10000) + `{"id":1}],"total":10001}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/documents":
+			_, _ = w.Write([]byte(largeHits + "\n"))
+		case "/invalid":
+			_, _ = w.Write([]byte(`{"message":`))
+		case "/invalid-large":
+			_, _ = w.Write([]byte(`{"message":"` + strings.Repeat("a", 2*defaultErrorBodyLimit) + `}`))
+		case "/mistyped":
+			_, _ = w.Write([]byte(`{"results":"carol","total":1}`))
+		}
+	}))
+	defer ts.Close()
+
+	recorder := &mockMetricsRecorder{}
+	c := newClient(&http.Client{}, ts.URL, "")
+	c.metrics = recorder
+
+	documents := &DocumentsResult{}
+	err := c.executeRequest(context.Background(), &internalRequest{
+		endpoint:            "/documents",
+		method:              http.MethodGet,
+		withResponse:        documents,
+		acceptedStatusCodes: []int{http.StatusOK},
+	})
+	require.NoError(t, err)
+	require.Len(t, documents.Results, 10001)
+	require.Equal(t, int64(10001), documents.Total)
+	require.Equal(t, int64(len(largeHits)+1), recorder.requests[0].BytesReceived)
+
+	err = c.executeRequest(context.Background(), &internalRequest{
+		endpoint:            "/invalid",
+		method:              http.MethodGet,
+		withResponse:        &mockResponse{},
+		acceptedStatusCodes: []int{http.StatusOK},
+	})
+	var meiliErr *Error
+	require.ErrorAs(t, err, &meiliErr)
+	require.Equal(t, ErrCodeResponseUnmarshalBody, meiliErr.ErrCode)
+	require.Equal(t, `{"message":`, meiliErr.ResponseToString)
+
+	err = c.executeRequest(context.Background(), &internalRequest{
+		endpoint:            "/invalid-large",
+		method:              http.MethodGet,
+		withResponse:        &mockResponse{},
+		acceptedStatusCodes: []int{http.StatusOK},
+	})
+	require.ErrorAs(t, err, &meiliErr)
+	require.Equal(t, ErrCodeResponseUnmarshalBody, meiliErr.ErrCode)
+	require.Len(t, meiliErr.ResponseToString, defaultErrorBodyLimit+len("... (truncated)"))
+	require.True(t, strings.HasSuffix(meiliErr.ResponseToString, "... (truncated)"))
+
+	// a valid JSON body that cannot be decoded into the response is kept too
+	err = c.executeRequest(context.Background(), &internalRequest{
+		endpoint:            "/mistyped",
+		method:              http.MethodGet,
+		withResponse:        &DocumentsResult{},
+		acceptedStatusCodes: []int{http.StatusOK},
+	})
+	require.ErrorAs(t, err, &meiliErr)
+	require.Equal(t, ErrCodeResponseUnmarshalBody, meiliErr.ErrCode)
+	require.Equal(t, `{"results":"carol","total":1}`, meiliErr.ResponseToString)
+}
+
+func TestExecuteRequest_StreamResponse(t *testing.T) {
+	largeHits := `{"hits":[` + strings.Repeat(`{"id":1},`, 10000) + `{"id":1}],"query":"carol"}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/indexes/movies/search":
+			_, _ = w.Write([]byte(largeHits))
+		case "/indexes/movies/documents/fetch":
+			b, _ := io.ReadAll(r.Body)
+			_, _ = w.Write(b)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index ` + "`unknown`" + ` not found.","code":"index_not_found"}`))
+		}
+	}))
+	defer ts.Close()
+
+	meili := New(ts.URL)
+
+	// the body is handed over unread, after the call returned
+	body, err := meili.Index("movies").SearchStream("carol", &SearchRequest{})
+	require.NoError(t, err)
+	b, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.NoError(t, body.Close())
+	require.Equal(t, largeHits, string(b))
+
+	body, err = meili.Index("movies").GetDocumentsStream(&DocumentsQuery{Filter: "id = 1"})
+	require.NoError(t, err)
+	b, err = io.ReadAll(body)
+	require.NoError(t, err)
+	require.NoError(t, body.Close())
+	require.JSONEq(t, `{"filter":"id = 1"}`, string(b))
+
+	_, err = meili.Index("movies").SearchStream("", nil)
+	require.ErrorIs(t, err, ErrNoSearchRequest)
+
+	body, err = meili.Index("unknown").SearchStream("carol", &SearchRequest{})
+	require.Nil(t, body)
+	var meiliErr *Error
+	require.ErrorAs(t, err, &meiliErr)
+	require.Equal(t, APIErrorCodeIndexNotFound, meiliErr.MeilisearchApiError.Code)
+}
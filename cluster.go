@@ -0,0 +1,168 @@
+package meilisearch
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// LoadBalancingStrategy selects the host serving a read request in a cluster created by NewCluster.
+type LoadBalancingStrategy int
+
+const (
+	// RoundRobin spreads the read requests evenly across the healthy hosts.
+	RoundRobin LoadBalancingStrategy = iota
+	// LeastLatency sends the read requests to the healthy host answering the health checks the fastest.
+	LeastLatency
+)
+
+const defaultHealthCheckInterval = 10 * time.Second
+
+// hostPool tracks the health of the hosts of a cluster, the first host being the primary.
+type hostPool struct {
+	hosts    []*clusterHost
+	strategy LoadBalancingStrategy
+	interval time.Duration
+
+	mu   sync.Mutex
+	next int
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+type clusterHost struct {
+	url     string
+	healthy bool
+	latency time.Duration
+}
+
+func newHostPool(hosts []string, strategy LoadBalancingStrategy, interval time.Duration) *hostPool {
+	if interval <= 0 {
+		interval = defaultHealthCheckInterval
+	}
+	pool := &hostPool{
+		hosts:    make([]*clusterHost, len(hosts)),
+		strategy: strategy,
+		interval: interval,
+		done:     make(chan struct{}),
+	}
+	for i, host := range hosts {
+		pool.hosts[i] = &clusterHost{url: host, healthy: true}
+	}
+	return pool
+}
+
+func (p *hostPool) primary() string {
+	return p.hosts[0].url
+}
+
+// pick returns the host that must serve a request. Writes are pinned to the primary host while
+// reads are balanced across the healthy hosts, falling back on the primary when none is healthy.
+func (p *hostPool) pick(readOnly bool) string {
+	if !readOnly {
+		return p.primary()
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var picked *clusterHost
+	switch p.strategy {
+	case LeastLatency:
+		for _, host := range p.hosts {
+			if host.healthy && (picked == nil || host.latency < picked.latency) {
+				picked = host
+			}
+		}
+	default:
+		for i := 0; i < len(p.hosts); i++ {
+			host := p.hosts[(p.next+i)%len(p.hosts)]
+			if host.healthy {
+				picked = host
+				p.next = (p.next + i + 1) % len(p.hosts)
+				break
+			}
+		}
+	}
+
+	if picked == nil {
+		return p.primary()
+	}
+	return picked.url
+}
+
+// healthyCount returns the number of hosts currently considered healthy.
+func (p *hostPool) healthyCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	count := 0
+	for _, host := range p.hosts {
+		if host.healthy {
+			count++
+		}
+	}
+	return count
+}
+
+// markDown ejects a host until the next successful health check.
+func (p *hostPool) markDown(url string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, host := range p.hosts {
+		if host.url == url {
+			host.healthy = false
+		}
+	}
+}
+
+// report records the result of a health check, the latency is smoothed with an exponentially
+// weighted moving average.
+func (p *hostPool) report(url string, healthy bool, latency time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, host := range p.hosts {
+		if host.url != url {
+			continue
+		}
+		host.healthy = healthy
+		if healthy {
+			if host.latency == 0 {
+				host.latency = latency
+			} else {
+				host.latency = (7*host.latency + 3*latency) / 10
+			}
+		}
+	}
+}
+
+// watch checks the health of every host until the pool is closed.
+func (p *hostPool) watch(check func(ctx context.Context, host string) bool) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, host := range p.hosts {
+			ctx, cancel := context.WithTimeout(context.Background(), p.interval)
+			start := time.Now()
+			healthy := check(ctx, host.url)
+			cancel()
+			p.report(host.url, healthy, time.Since(start))
+		}
+
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *hostPool) close() {
+	p.closeOnce.Do(func() {
+		close(p.done)
+	})
+}
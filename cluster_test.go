@@ -0,0 +1,154 @@
+package meilisearch
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type clusterTestServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	healthy  bool
+	requests []string
+}
+
+func newClusterTestServer() *clusterTestServer {
+	s := &clusterTestServer{healthy: true}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if r.URL.Path == "/health" {
+			if !s.healthy {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"status":"available"}`))
+			return
+		}
+
+		s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodPost && r.URL.Path == "/indexes/movies/search" {
+			_, _ = w.Write([]byte(`{"hits":[],"processingTimeMs":1,"query":""}`))
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid":1}`))
+	}))
+	return s
+}
+
+func (s *clusterTestServer) setHealthy(healthy bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.healthy = healthy
+}
+
+func (s *clusterTestServer) requestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.requests)
+}
+
+func TestNewCluster_NoHosts(t *testing.T) {
+	_, err := NewCluster(nil)
+	require.ErrorIs(t, err, ErrNoClusterHosts)
+}
+
+func TestNewCluster_ReadsAndWrites(t *testing.T) {
+	primary := newClusterTestServer()
+	defer primary.Close()
+	replica := newClusterTestServer()
+	defer replica.Close()
+
+	meili, err := NewCluster([]string{primary.URL, replica.URL}, WithHealthCheckInterval(time.Hour))
+	require.NoError(t, err)
+	defer meili.Close()
+
+	idx := meili.Index("movies")
+	for i := 0; i < 4; i++ {
+		_, err := idx.Search("", &SearchRequest{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, primary.requestCount())
+	require.Equal(t, 2, replica.requestCount())
+
+	_, err = idx.DeleteAllDocuments()
+	require.NoError(t, err)
+	require.Equal(t, 3, primary.requestCount())
+	require.Equal(t, 2, replica.requestCount())
+}
+
+func TestNewCluster_Failover(t *testing.T) {
+	down := newClusterTestServer()
+	downURL := down.URL
+	down.Close()
+	up := newClusterTestServer()
+	defer up.Close()
+
+	meili, err := NewCluster([]string{downURL, up.URL}, WithHealthCheckInterval(time.Hour))
+	require.NoError(t, err)
+	defer meili.Close()
+
+	idx := meili.Index("movies")
+	for i := 0; i < 3; i++ {
+		_, err := idx.Search("", &SearchRequest{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, up.requestCount())
+}
+
+func TestNewCluster_HealthChecks(t *testing.T) {
+	primary := newClusterTestServer()
+	defer primary.Close()
+	replica := newClusterTestServer()
+	defer replica.Close()
+	replica.setHealthy(false)
+
+	meili, err := NewCluster(
+		[]string{primary.URL, replica.URL},
+		WithHealthCheckInterval(10*time.Millisecond),
+		WithLoadBalancing(RoundRobin),
+	)
+	require.NoError(t, err)
+	defer meili.Close()
+
+	pool := meili.(*meilisearch).client.hostPool
+	require.Eventually(t, func() bool {
+		return pool.healthyCount() == 1
+	}, time.Second, 5*time.Millisecond)
+
+	idx := meili.Index("movies")
+	for i := 0; i < 2; i++ {
+		_, err := idx.Search("", &SearchRequest{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, primary.requestCount())
+	require.Equal(t, 0, replica.requestCount())
+
+	replica.setHealthy(true)
+	require.Eventually(t, func() bool {
+		return pool.healthyCount() == 2
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestHostPool_LeastLatency(t *testing.T) {
+	pool := newHostPool([]string{"http://primary", "http://replica"}, LeastLatency, time.Hour)
+	pool.report("http://primary", true, 20*time.Millisecond)
+	pool.report("http://replica", true, 5*time.Millisecond)
+
+	require.Equal(t, "http://replica", pool.pick(true))
+	require.Equal(t, "http://primary", pool.pick(false))
+
+	pool.markDown("http://replica")
+	require.Equal(t, "http://primary", pool.pick(true))
+
+	pool.markDown("http://primary")
+	require.Equal(t, "http://primary", pool.pick(true))
+}
@@ -0,0 +1,124 @@
+package meilisearch
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"io"
+
+	"github.com/andybalholm/brotli"
+)
+
+// ContentEncoding is the algorithm compressing the request bodies sent to Meilisearch, and the
+// payloads posted by Meilisearch to a task webhook.
+type ContentEncoding string
+
+const (
+	// NoEncoding sends the request bodies uncompressed.
+	NoEncoding ContentEncoding = ""
+	// GzipEncoding compresses the request bodies with gzip.
+	GzipEncoding ContentEncoding = "gzip"
+	// DeflateEncoding compresses the request bodies with deflate, in the zlib format.
+	DeflateEncoding ContentEncoding = "deflate"
+	// BrotliEncoding compresses the request bodies with brotli.
+	BrotliEncoding ContentEncoding = "br"
+)
+
+type contentEncodingKey struct{}
+
+// ContextWithContentEncoding overrides the content encoding of the client for the requests
+// sent with the returned context, e.g. to compress a single large document upload. NoEncoding
+// sends them uncompressed.
+func ContextWithContentEncoding(ctx context.Context, encoding ContentEncoding) context.Context {
+	return context.WithValue(ctx, contentEncodingKey{}, encoding)
+}
+
+// contentEncoding returns the encoding of the request bodies sent with ctx.
+func (c *client) contentEncoding(ctx context.Context) ContentEncoding {
+	if encoding, ok := ctx.Value(contentEncodingKey{}).(ContentEncoding); ok {
+		return encoding
+	}
+	return c.encoding
+}
+
+func (e ContentEncoding) valid() bool {
+	switch e {
+	case NoEncoding, GzipEncoding, DeflateEncoding, BrotliEncoding:
+		return true
+	}
+	return false
+}
+
+func (e ContentEncoding) newWriter(w io.Writer) io.WriteCloser {
+	switch e {
+	case GzipEncoding:
+		return gzip.NewWriter(w)
+	case DeflateEncoding:
+		return zlib.NewWriter(w)
+	default:
+		return brotli.NewWriter(w)
+	}
+}
+
+// newReader decompresses r, compressed with encoding.
+func (e ContentEncoding) newReader(r io.Reader) (io.ReadCloser, error) {
+	switch e {
+	case NoEncoding:
+		return io.NopCloser(r), nil
+	case GzipEncoding:
+		return gzip.NewReader(r)
+	case DeflateEncoding:
+		return zlib.NewReader(r)
+	case BrotliEncoding:
+		return io.NopCloser(brotli.NewReader(r)), nil
+	}
+	return nil, ErrInvalidContentEncoding
+}
+
+// compression streams the compressed body of a single attempt through a pipe, so that the
+// body is never held in memory in full.
+type compression struct {
+	reader *io.PipeReader
+	done   chan struct{}
+}
+
+// compress starts compressing source with encoding, the compressed bytes written are added to
+// count.
+func compress(source io.Reader, encoding ContentEncoding, count *int64) *compression {
+	reader, writer := io.Pipe()
+	c := &compression{
+		reader: reader,
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		defer close(c.done)
+
+		encoder := encoding.newWriter(&countingWriter{writer: writer, count: count})
+		_, err := io.Copy(encoder, source)
+		if closeErr := encoder.Close(); err == nil {
+			err = closeErr
+		}
+		_ = writer.CloseWithError(err)
+	}()
+
+	return c
+}
+
+// close stops the compression and waits for it to be over, the source can then be read again.
+func (c *compression) close() {
+	_ = c.reader.Close()
+	<-c.done
+}
+
+// countingWriter counts the bytes written to writer.
+type countingWriter struct {
+	writer io.Writer
+	count  *int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	*w.count += int64(n)
+	return n, err
+}
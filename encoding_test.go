@@ -0,0 +1,161 @@
+package meilisearch
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/require"
+)
+
+// compressionTestServer decodes the request bodies according to their Content-Encoding, the
+// given number of first requests are answered with a 503.
+func compressionTestServer(t *testing.T, failures int) (*httptest.Server, func() ([]string, []string)) {
+	var mu sync.Mutex
+	var encodings, bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reader io.Reader = r.Body
+		var err error
+		switch r.Header.Get("Content-Encoding") {
+		case "gzip":
+			reader, err = gzip.NewReader(r.Body)
+		case "deflate":
+			reader, err = zlib.NewReader(r.Body)
+		case "br":
+			reader = brotli.NewReader(r.Body)
+		}
+		require.NoError(t, err)
+		b, err := io.ReadAll(reader)
+		require.NoError(t, err)
+
+		mu.Lock()
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+		bodies = append(bodies, string(b))
+		failed := len(bodies) <= failures
+		mu.Unlock()
+
+		if failed {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid":1}`))
+	}))
+	return ts, func() ([]string, []string) {
+		mu.Lock()
+		defer mu.Unlock()
+		return encodings, bodies
+	}
+}
+
+func TestWithContentEncoding(t *testing.T) {
+	documents := strings.Repeat(`{"id":1,"title":"Carol"}`+"\n", 1000)
+
+	tests := []struct {
+		name     string
+		encoding ContentEncoding
+		body     func() interface{}
+	}{
+		{
+			name:     "Gzip reader",
+			encoding: GzipEncoding,
+			body:     func() interface{} { return io.MultiReader(strings.NewReader(documents)) },
+		},
+		{
+			name:     "Deflate bytes",
+			encoding: DeflateEncoding,
+			body:     func() interface{} { return []byte(documents) },
+		},
+		{
+			name:     "Brotli seekable reader",
+			encoding: BrotliEncoding,
+			body:     func() interface{} { return strings.NewReader(documents) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, requests := compressionTestServer(t, 0)
+			defer ts.Close()
+
+			recorder := &mockMetricsRecorder{}
+			c := newClientWithOptions(ts.URL, newMeiliOpt(WithContentEncoding(tt.encoding), WithMetricsRecorder(recorder)))
+			err := c.executeRequest(context.Background(), &internalRequest{
+				endpoint:            "/indexes/movies/documents",
+				method:              http.MethodPost,
+				contentType:         contentTypeNDJSON,
+				withRequest:         tt.body(),
+				acceptedStatusCodes: []int{http.StatusAccepted},
+			})
+			require.NoError(t, err)
+
+			encodings, bodies := requests()
+			require.Equal(t, []string{string(tt.encoding)}, encodings)
+			require.Equal(t, []string{documents}, bodies)
+
+			require.Len(t, recorder.requests, 1)
+			require.NotZero(t, recorder.requests[0].BytesSent)
+			require.Less(t, recorder.requests[0].BytesSent, int64(len(documents)))
+		})
+	}
+}
+
+func TestWithContentEncoding_Retry(t *testing.T) {
+	ts, requests := compressionTestServer(t, 1)
+	defer ts.Close()
+
+	c := newClientWithOptions(ts.URL, newMeiliOpt(
+		WithContentEncoding(GzipEncoding),
+		WithRetryPolicy(&RetryPolicy{
+			MaxAttempts:    2,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Millisecond,
+			RetryOnStatus:  []int{http.StatusServiceUnavailable},
+		}),
+	))
+	err := c.executeRequest(context.Background(), &internalRequest{
+		endpoint:            "/indexes/movies/settings/ranking-rules",
+		method:              http.MethodPut,
+		contentType:         contentTypeJSON,
+		withRequest:         strings.NewReader(`["words"]`),
+		acceptedStatusCodes: []int{http.StatusAccepted},
+	})
+	require.NoError(t, err)
+
+	encodings, bodies := requests()
+	require.Equal(t, []string{"gzip", "gzip"}, encodings)
+	require.Equal(t, []string{`["words"]`, `["words"]`}, bodies)
+}
+
+func TestContextWithContentEncoding(t *testing.T) {
+	ts, requests := compressionTestServer(t, 0)
+	defer ts.Close()
+
+	meili := New(ts.URL, WithContentEncoding(BrotliEncoding))
+	idx := meili.Index("movies")
+
+	_, err := idx.AddDocumentsNdjsonFromReaderWithContext(
+		ContextWithContentEncoding(context.Background(), GzipEncoding), strings.NewReader(`{"id":1}`))
+	require.NoError(t, err)
+	_, err = idx.AddDocumentsNdjsonFromReaderWithContext(
+		ContextWithContentEncoding(context.Background(), NoEncoding), strings.NewReader(`{"id":2}`))
+	require.NoError(t, err)
+	_, err = idx.AddDocumentsNdjsonFromReader(strings.NewReader(`{"id":3}`))
+	require.NoError(t, err)
+
+	encodings, bodies := requests()
+	require.Equal(t, []string{"gzip", "", "br"}, encodings)
+	require.Equal(t, []string{`{"id":1}`, `{"id":2}`, `{"id":3}`}, bodies)
+
+	_, err = idx.AddDocumentsNdjsonFromReaderWithContext(
+		ContextWithContentEncoding(context.Background(), "zstd"), strings.NewReader(`{"id":4}`))
+	require.ErrorIs(t, err, ErrInvalidContentEncoding)
+}
@@ -25,6 +25,10 @@ const (
 	MeilisearchTimeoutError
 	// MeilisearchCommunicationError impossible execute a request
 	MeilisearchCommunicationError
+	// MeilisearchTaskError a task processed by meilisearch failed
+	MeilisearchTaskError
+	// ErrCodeTaskNotFound a waited task is unknown to meilisearch
+	ErrCodeTaskNotFound
 )
 
 const (
@@ -35,6 +39,8 @@ const (
 	rawStringMeilisearchApiErrorWithoutMessage = `unaccepted status code found: ${statusCode} expected: ${statusCodeExpected}, MeilisearchApiError Message: ${message}`
 	rawStringMeilisearchTimeoutError           = `MeilisearchTimeoutError`
 	rawStringMeilisearchCommunicationError     = `MeilisearchCommunicationError unable to execute request`
+	rawStringMeilisearchTaskError              = `task failed, MeilisearchApiError Message: ${message}, Code: ${code}, Type: ${type}, Link: ${link}`
+	rawStringTaskNotFound                      = `task not found, MeilisearchApiError Message: ${message}, Code: ${code}`
 )
 
 func (e ErrCode) rawMessage() string {
@@ -51,16 +57,22 @@ func (e ErrCode) rawMessage() string {
 		return rawStringMeilisearchTimeoutError + " " + rawStringCtx
 	case MeilisearchCommunicationError:
 		return rawStringMeilisearchCommunicationError + " " + rawStringCtx
+	case MeilisearchTaskError:
+		return rawStringMeilisearchTaskError
+	case ErrCodeTaskNotFound:
+		return rawStringTaskNotFound + " " + rawStringCtx
 	default:
 		return rawStringCtx
 	}
 }
 
-type meilisearchApiError struct {
-	Message string `json:"message"`
-	Code    string `json:"code"`
-	Type    string `json:"type"`
-	Link    string `json:"link"`
+// APIError is the error sent by meilisearch, either in the body of a response or as the error
+// of a failed task.
+type APIError struct {
+	Message string       `json:"message"`
+	Code    APIErrorCode `json:"code"`
+	Type    string       `json:"type"`
+	Link    string       `json:"link"`
 }
 
 // Error is the internal error structure that all exposed method use.
@@ -83,7 +95,7 @@ type Error struct {
 
 	// Error info from meilisearch api
 	// Message is the raw request into string ('empty meilisearch message' if not present)
-	MeilisearchApiError meilisearchApiError
+	MeilisearchApiError APIError
 
 	// StatusCode of the request
 	StatusCode int
@@ -101,14 +113,15 @@ type Error struct {
 	ErrCode ErrCode
 }
 
-// Error return a well human formatted message.
+// Error return a well human formatted message. The request and response bodies are truncated, the
+// fields RequestToString and ResponseToString hold them as captured.
 func (e *Error) Error() string {
 	message := namedSprintf(e.rawMessage, map[string]interface{}{
 		"endpoint":           e.Endpoint,
 		"method":             e.Method,
 		"function":           e.Function,
-		"request":            e.RequestToString,
-		"response":           e.ResponseToString,
+		"request":            truncate(e.RequestToString, errorMessageBodyLimit),
+		"response":           truncate(e.ResponseToString, errorMessageBodyLimit),
 		"statusCodeExpected": e.StatusCodeExpected,
 		"statusCode":         e.StatusCode,
 		"message":            e.MeilisearchApiError.Message,
@@ -123,6 +136,11 @@ func (e *Error) Error() string {
 	return message
 }
 
+// Unwrap returns the origin error, so that errors.Is and errors.As can inspect it.
+func (e *Error) Unwrap() error {
+	return e.OriginError
+}
+
 // WithErrCode add an error code to an error
 func (e *Error) WithErrCode(err ErrCode, errs ...error) *Error {
 	if errs != nil {
@@ -137,7 +155,7 @@ func (e *Error) WithErrCode(err ErrCode, errs ...error) *Error {
 // ErrorBody add a body to an error
 func (e *Error) ErrorBody(body []byte) {
 	e.ResponseToString = string(body)
-	msg := meilisearchApiError{}
+	msg := APIError{}
 	err := json.Unmarshal(body, &msg)
 	if err == nil {
 		e.MeilisearchApiError.Message = msg.Message
@@ -147,6 +165,45 @@ func (e *Error) ErrorBody(body []byte) {
 	}
 }
 
+// TaskFailedError is returned when waiting for the success of a task which failed or was
+// canceled. It unwraps to the *Error of the task when it failed, so that errors.Is can test its
+// code, e.g. errors.Is(err, ErrIndexNotFound).
+type TaskFailedError struct {
+	// Task is the task as it was once processed.
+	Task *Task
+}
+
+// Error return a well human formatted message.
+func (e *TaskFailedError) Error() string {
+	if e.Task.Status == TaskStatusCanceled {
+		return fmt.Sprintf("task %d of type %s was canceled by task %d", e.Task.UID, e.Task.Type, e.Task.CanceledBy)
+	}
+	return fmt.Sprintf("task %d of type %s failed: %s (%s)", e.Task.UID, e.Task.Type, e.Task.Error.Message, e.Task.Error.Code)
+}
+
+// Unwrap returns the error of the task as an *Error, nil when the task was canceled.
+func (e *TaskFailedError) Unwrap() error {
+	return e.Task.Err()
+}
+
+// Code returns the code of the error of the task, empty when the task was canceled.
+func (e *TaskFailedError) Code() APIErrorCode {
+	return e.Task.Error.Code
+}
+
+// SearchTruncatedError is returned by a SearchIterator which walked the maxTotalHits of the
+// pagination settings of the index, Meilisearch returning no more hits even though the search
+// may match more documents. Raise the maxTotalHits with UpdatePagination to walk them.
+type SearchTruncatedError struct {
+	// MaxTotalHits is the maxTotalHits of the pagination settings of the index.
+	MaxTotalHits int64
+}
+
+// Error return a well human formatted message.
+func (e *SearchTruncatedError) Error() string {
+	return fmt.Sprintf("search results truncated at %d hits, the maxTotalHits of the pagination settings of the index", e.MaxTotalHits)
+}
+
 // VersionErrorHintMessage a hint to the error message if it may come from a version incompatibility with meilisearch
 func VersionErrorHintMessage(err error, req *internalRequest) error {
 	return fmt.Errorf("%w. Hint: It might not be working because you're not up to date with the "+
@@ -167,4 +224,6 @@ var (
 	ErrNoSearchRequest               = errors.New("no search request provided")
 	ErrNoFacetSearchRequest          = errors.New("no search facet request provided")
 	ErrConnectingFailed              = errors.New("meilisearch is not connected")
+	ErrNoClusterHosts                = errors.New("at least one host is required to create a cluster")
+	ErrInvalidContentEncoding        = errors.New("content encoding must be one of gzip, deflate or br")
 )
@@ -0,0 +1,228 @@
+package meilisearch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// APIErrorCode is the code sent by Meilisearch along with an error, it identifies the error
+// more precisely than the status code.
+// more: https://www.meilisearch.com/docs/reference/errors/error_codes
+type APIErrorCode string
+
+const (
+	APIErrorCodeAPIKeyAlreadyExists                  APIErrorCode = "api_key_already_exists"
+	APIErrorCodeAPIKeyNotFound                       APIErrorCode = "api_key_not_found"
+	APIErrorCodeBadRequest                           APIErrorCode = "bad_request"
+	APIErrorCodeBatchNotFound                        APIErrorCode = "batch_not_found"
+	APIErrorCodeDatabaseSizeLimitReached             APIErrorCode = "database_size_limit_reached"
+	APIErrorCodeDocumentFieldsLimitReached           APIErrorCode = "document_fields_limit_reached"
+	APIErrorCodeDocumentNotFound                     APIErrorCode = "document_not_found"
+	APIErrorCodeDumpProcessFailed                    APIErrorCode = "dump_process_failed"
+	APIErrorCodeDuplicateIndexFound                  APIErrorCode = "duplicate_index_found"
+	APIErrorCodeFeatureNotEnabled                    APIErrorCode = "feature_not_enabled"
+	APIErrorCodeImmutableAPIKeyActions               APIErrorCode = "immutable_api_key_actions"
+	APIErrorCodeImmutableAPIKeyCreatedAt             APIErrorCode = "immutable_api_key_created_at"
+	APIErrorCodeImmutableAPIKeyExpiresAt             APIErrorCode = "immutable_api_key_expires_at"
+	APIErrorCodeImmutableAPIKeyIndexes               APIErrorCode = "immutable_api_key_indexes"
+	APIErrorCodeImmutableAPIKeyKey                   APIErrorCode = "immutable_api_key_key"
+	APIErrorCodeImmutableAPIKeyUID                   APIErrorCode = "immutable_api_key_uid"
+	APIErrorCodeImmutableAPIKeyUpdatedAt             APIErrorCode = "immutable_api_key_updated_at"
+	APIErrorCodeImmutableIndexCreatedAt              APIErrorCode = "immutable_index_created_at"
+	APIErrorCodeImmutableIndexUID                    APIErrorCode = "immutable_index_uid"
+	APIErrorCodeImmutableIndexUpdatedAt              APIErrorCode = "immutable_index_updated_at"
+	APIErrorCodeIndexAlreadyExists                   APIErrorCode = "index_already_exists"
+	APIErrorCodeIndexCreationFailed                  APIErrorCode = "index_creation_failed"
+	APIErrorCodeIndexNotFound                        APIErrorCode = "index_not_found"
+	APIErrorCodeIndexPrimaryKeyAlreadyExists         APIErrorCode = "index_primary_key_already_exists"
+	APIErrorCodeIndexPrimaryKeyMultipleCandidates    APIErrorCode = "index_primary_key_multiple_candidates_found"
+	APIErrorCodeIndexPrimaryKeyNoCandidateFound      APIErrorCode = "index_primary_key_no_candidate_found"
+	APIErrorCodeInternal                             APIErrorCode = "internal"
+	APIErrorCodeInvalidAPIKey                        APIErrorCode = "invalid_api_key"
+	APIErrorCodeInvalidAPIKeyActions                 APIErrorCode = "invalid_api_key_actions"
+	APIErrorCodeInvalidAPIKeyDescription             APIErrorCode = "invalid_api_key_description"
+	APIErrorCodeInvalidAPIKeyExpiresAt               APIErrorCode = "invalid_api_key_expires_at"
+	APIErrorCodeInvalidAPIKeyIndexes                 APIErrorCode = "invalid_api_key_indexes"
+	APIErrorCodeInvalidAPIKeyLimit                   APIErrorCode = "invalid_api_key_limit"
+	APIErrorCodeInvalidAPIKeyName                    APIErrorCode = "invalid_api_key_name"
+	APIErrorCodeInvalidAPIKeyOffset                  APIErrorCode = "invalid_api_key_offset"
+	APIErrorCodeInvalidAPIKeyUID                     APIErrorCode = "invalid_api_key_uid"
+	APIErrorCodeInvalidContentType                   APIErrorCode = "invalid_content_type"
+	APIErrorCodeInvalidDocumentCsvDelimiter          APIErrorCode = "invalid_document_csv_delimiter"
+	APIErrorCodeInvalidDocumentFields                APIErrorCode = "invalid_document_fields"
+	APIErrorCodeInvalidDocumentFilter                APIErrorCode = "invalid_document_filter"
+	APIErrorCodeInvalidDocumentGeoField              APIErrorCode = "invalid_document_geo_field"
+	APIErrorCodeInvalidDocumentID                    APIErrorCode = "invalid_document_id"
+	APIErrorCodeInvalidDocumentLimit                 APIErrorCode = "invalid_document_limit"
+	APIErrorCodeInvalidDocumentOffset                APIErrorCode = "invalid_document_offset"
+	APIErrorCodeInvalidFacetSearchFacetName          APIErrorCode = "invalid_facet_search_facet_name"
+	APIErrorCodeInvalidFacetSearchFacetQuery         APIErrorCode = "invalid_facet_search_facet_query"
+	APIErrorCodeInvalidIndexLimit                    APIErrorCode = "invalid_index_limit"
+	APIErrorCodeInvalidIndexOffset                   APIErrorCode = "invalid_index_offset"
+	APIErrorCodeInvalidIndexPrimaryKey               APIErrorCode = "invalid_index_primary_key"
+	APIErrorCodeInvalidIndexUID                      APIErrorCode = "invalid_index_uid"
+	APIErrorCodeInvalidSearchAttributesToCrop        APIErrorCode = "invalid_search_attributes_to_crop"
+	APIErrorCodeInvalidSearchAttributesToHighlight   APIErrorCode = "invalid_search_attributes_to_highlight"
+	APIErrorCodeInvalidSearchAttributesToRetrieve    APIErrorCode = "invalid_search_attributes_to_retrieve"
+	APIErrorCodeInvalidSearchCropLength              APIErrorCode = "invalid_search_crop_length"
+	APIErrorCodeInvalidSearchCropMarker              APIErrorCode = "invalid_search_crop_marker"
+	APIErrorCodeInvalidSearchEmbedder                APIErrorCode = "invalid_search_embedder"
+	APIErrorCodeInvalidSearchFacets                  APIErrorCode = "invalid_search_facets"
+	APIErrorCodeInvalidSearchFilter                  APIErrorCode = "invalid_search_filter"
+	APIErrorCodeInvalidSearchHighlightPostTag        APIErrorCode = "invalid_search_highlight_post_tag"
+	APIErrorCodeInvalidSearchHighlightPreTag         APIErrorCode = "invalid_search_highlight_pre_tag"
+	APIErrorCodeInvalidSearchHitsPerPage             APIErrorCode = "invalid_search_hits_per_page"
+	APIErrorCodeInvalidSearchHybridQuery             APIErrorCode = "invalid_search_hybrid_query"
+	APIErrorCodeInvalidSearchLimit                   APIErrorCode = "invalid_search_limit"
+	APIErrorCodeInvalidSearchMatchingStrategy        APIErrorCode = "invalid_search_matching_strategy"
+	APIErrorCodeInvalidSearchOffset                  APIErrorCode = "invalid_search_offset"
+	APIErrorCodeInvalidSearchPage                    APIErrorCode = "invalid_search_page"
+	APIErrorCodeInvalidSearchQ                       APIErrorCode = "invalid_search_q"
+	APIErrorCodeInvalidSearchRankingScoreThreshold   APIErrorCode = "invalid_search_ranking_score_threshold"
+	APIErrorCodeInvalidSearchShowMatchesPosition     APIErrorCode = "invalid_search_show_matches_position"
+	APIErrorCodeInvalidSearchShowRankingScore        APIErrorCode = "invalid_search_show_ranking_score"
+	APIErrorCodeInvalidSearchShowRankingScoreDetails APIErrorCode = "invalid_search_show_ranking_score_details"
+	APIErrorCodeInvalidSearchSort                    APIErrorCode = "invalid_search_sort"
+	APIErrorCodeInvalidSearchVector                  APIErrorCode = "invalid_search_vector"
+	APIErrorCodeInvalidSettingsDisplayedAttributes   APIErrorCode = "invalid_settings_displayed_attributes"
+	APIErrorCodeInvalidSettingsDistinctAttribute     APIErrorCode = "invalid_settings_distinct_attribute"
+	APIErrorCodeInvalidSettingsEmbedders             APIErrorCode = "invalid_settings_embedders"
+	APIErrorCodeInvalidSettingsFaceting              APIErrorCode = "invalid_settings_faceting"
+	APIErrorCodeInvalidSettingsFilterableAttributes  APIErrorCode = "invalid_settings_filterable_attributes"
+	APIErrorCodeInvalidSettingsPagination            APIErrorCode = "invalid_settings_pagination"
+	APIErrorCodeInvalidSettingsRankingRules          APIErrorCode = "invalid_settings_ranking_rules"
+	APIErrorCodeInvalidSettingsSearchableAttributes  APIErrorCode = "invalid_settings_searchable_attributes"
+	APIErrorCodeInvalidSettingsSortableAttributes    APIErrorCode = "invalid_settings_sortable_attributes"
+	APIErrorCodeInvalidSettingsStopWords             APIErrorCode = "invalid_settings_stop_words"
+	APIErrorCodeInvalidSettingsSynonyms              APIErrorCode = "invalid_settings_synonyms"
+	APIErrorCodeInvalidSettingsTypoTolerance         APIErrorCode = "invalid_settings_typo_tolerance"
+	APIErrorCodeInvalidSimilarID                     APIErrorCode = "invalid_similar_id"
+	APIErrorCodeInvalidState                         APIErrorCode = "invalid_state"
+	APIErrorCodeInvalidStoreFile                     APIErrorCode = "invalid_store_file"
+	APIErrorCodeInvalidSwapDuplicateIndexFound       APIErrorCode = "invalid_swap_duplicate_index_found"
+	APIErrorCodeInvalidSwapIndexes                   APIErrorCode = "invalid_swap_indexes"
+	APIErrorCodeInvalidTaskAfterEnqueuedAt           APIErrorCode = "invalid_task_after_enqueued_at"
+	APIErrorCodeInvalidTaskAfterFinishedAt           APIErrorCode = "invalid_task_after_finished_at"
+	APIErrorCodeInvalidTaskAfterStartedAt            APIErrorCode = "invalid_task_after_started_at"
+	APIErrorCodeInvalidTaskBeforeEnqueuedAt          APIErrorCode = "invalid_task_before_enqueued_at"
+	APIErrorCodeInvalidTaskBeforeFinishedAt          APIErrorCode = "invalid_task_before_finished_at"
+	APIErrorCodeInvalidTaskBeforeStartedAt           APIErrorCode = "invalid_task_before_started_at"
+	APIErrorCodeInvalidTaskCanceledBy                APIErrorCode = "invalid_task_canceled_by"
+	APIErrorCodeInvalidTaskIndexUids                 APIErrorCode = "invalid_task_index_uids"
+	APIErrorCodeInvalidTaskLimit                     APIErrorCode = "invalid_task_limit"
+	APIErrorCodeInvalidTaskStatuses                  APIErrorCode = "invalid_task_statuses"
+	APIErrorCodeInvalidTaskTypes                     APIErrorCode = "invalid_task_types"
+	APIErrorCodeInvalidTaskUids                      APIErrorCode = "invalid_task_uids"
+	APIErrorCodeIOError                              APIErrorCode = "io_error"
+	APIErrorCodeMalformedPayload                     APIErrorCode = "malformed_payload"
+	APIErrorCodeMaxFieldsLimitExceeded               APIErrorCode = "max_fields_limit_exceeded"
+	APIErrorCodeMissingAPIKeyActions                 APIErrorCode = "missing_api_key_actions"
+	APIErrorCodeMissingAPIKeyExpiresAt               APIErrorCode = "missing_api_key_expires_at"
+	APIErrorCodeMissingAPIKeyIndexes                 APIErrorCode = "missing_api_key_indexes"
+	APIErrorCodeMissingAuthorizationHeader           APIErrorCode = "missing_authorization_header"
+	APIErrorCodeMissingContentType                   APIErrorCode = "missing_content_type"
+	APIErrorCodeMissingDocumentFilter                APIErrorCode = "missing_document_filter"
+	APIErrorCodeMissingDocumentID                    APIErrorCode = "missing_document_id"
+	APIErrorCodeMissingFacetSearchFacetName          APIErrorCode = "missing_facet_search_facet_name"
+	APIErrorCodeMissingIndexUID                      APIErrorCode = "missing_index_uid"
+	APIErrorCodeMissingMasterKey                     APIErrorCode = "missing_master_key"
+	APIErrorCodeMissingPayload                       APIErrorCode = "missing_payload"
+	APIErrorCodeMissingSwapIndexes                   APIErrorCode = "missing_swap_indexes"
+	APIErrorCodeMissingTaskFilters                   APIErrorCode = "missing_task_filters"
+	APIErrorCodeNoSpaceLeftOnDevice                  APIErrorCode = "no_space_left_on_device"
+	APIErrorCodeNotFound                             APIErrorCode = "not_found"
+	APIErrorCodePayloadTooLarge                      APIErrorCode = "payload_too_large"
+	APIErrorCodeTaskNotFound                         APIErrorCode = "task_not_found"
+	APIErrorCodeTooManyOpenFiles                     APIErrorCode = "too_many_open_files"
+	APIErrorCodeTooManySearchRequests                APIErrorCode = "too_many_search_requests"
+	APIErrorCodeUnretrievableDocument                APIErrorCode = "unretrievable_document"
+	APIErrorCodeVectorEmbeddingError                 APIErrorCode = "vector_embedding_error"
+)
+
+// Errors matching the *Error returned by a request which failed with the corresponding API error
+// code, use errors.Is to test them, e.g. errors.Is(err, ErrIndexNotFound).
+var (
+	ErrAPIKeyNotFound             = errors.New("meilisearch: api key not found")
+	ErrBatchNotFound              = errors.New("meilisearch: batch not found")
+	ErrDocumentNotFound           = errors.New("meilisearch: document not found")
+	ErrIndexAlreadyExists         = errors.New("meilisearch: index already exists")
+	ErrIndexNotFound              = errors.New("meilisearch: index not found")
+	ErrInvalidAPIKey              = errors.New("meilisearch: invalid api key")
+	ErrMissingAuthorizationHeader = errors.New("meilisearch: missing authorization header")
+	ErrTaskNotFound               = errors.New("meilisearch: task not found")
+	ErrTooManySearchRequests      = errors.New("meilisearch: too many search requests")
+)
+
+var apiErrorSentinels = map[APIErrorCode]error{
+	APIErrorCodeAPIKeyNotFound:             ErrAPIKeyNotFound,
+	APIErrorCodeBatchNotFound:              ErrBatchNotFound,
+	APIErrorCodeDocumentNotFound:           ErrDocumentNotFound,
+	APIErrorCodeIndexAlreadyExists:         ErrIndexAlreadyExists,
+	APIErrorCodeIndexNotFound:              ErrIndexNotFound,
+	APIErrorCodeInvalidAPIKey:              ErrInvalidAPIKey,
+	APIErrorCodeMissingAuthorizationHeader: ErrMissingAuthorizationHeader,
+	APIErrorCodeTaskNotFound:               ErrTaskNotFound,
+	APIErrorCodeTooManySearchRequests:      ErrTooManySearchRequests,
+}
+
+// Is reports whether target is the sentinel error of the API error code of e.
+func (e *Error) Is(target error) bool {
+	sentinel, ok := apiErrorSentinels[e.MeilisearchApiError.Code]
+	return ok && sentinel == target
+}
+
+// IsNotFound reports whether err was returned because the index, document, key, task or batch
+// it targets does not exist.
+func IsNotFound(err error) bool {
+	var meiliErr *Error
+	if !errors.As(err, &meiliErr) {
+		return false
+	}
+	switch meiliErr.MeilisearchApiError.Code {
+	case APIErrorCodeAPIKeyNotFound, APIErrorCodeBatchNotFound, APIErrorCodeDocumentNotFound,
+		APIErrorCodeIndexNotFound, APIErrorCodeTaskNotFound, APIErrorCodeNotFound:
+		return true
+	}
+	return meiliErr.StatusCode == http.StatusNotFound
+}
+
+// IsAuthError reports whether err was returned because the API key is missing, invalid or not
+// allowed to perform the request.
+func IsAuthError(err error) bool {
+	var meiliErr *Error
+	if !errors.As(err, &meiliErr) {
+		return false
+	}
+	switch meiliErr.MeilisearchApiError.Code {
+	case APIErrorCodeInvalidAPIKey, APIErrorCodeMissingAuthorizationHeader, APIErrorCodeMissingMasterKey:
+		return true
+	}
+	return meiliErr.StatusCode == http.StatusUnauthorized || meiliErr.StatusCode == http.StatusForbidden
+}
+
+// IsRetryable reports whether err is transient, so that sending the same request later may
+// succeed: the request timed out, Meilisearch could not be reached or it is overloaded.
+func IsRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var meiliErr *Error
+	if !errors.As(err, &meiliErr) {
+		return false
+	}
+	switch meiliErr.ErrCode {
+	case MeilisearchTimeoutError, MeilisearchCommunicationError:
+		return true
+	}
+	switch meiliErr.MeilisearchApiError.Code {
+	case APIErrorCodeTooManySearchRequests, APIErrorCodeTooManyOpenFiles:
+		return true
+	}
+	switch meiliErr.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
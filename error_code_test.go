@@ -0,0 +1,102 @@
+package meilisearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestError_Is(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Index ` + "`movies`" + ` not found.","code":"index_not_found","type":"invalid_request","link":"https://docs.meilisearch.com/errors#index_not_found"}`))
+	}))
+	defer ts.Close()
+
+	_, err := New(ts.URL).GetIndex("movies")
+	require.ErrorIs(t, err, ErrIndexNotFound)
+	require.ErrorIs(t, fmt.Errorf("wrapped: %w", err), ErrIndexNotFound)
+	require.NotErrorIs(t, err, ErrDocumentNotFound)
+
+	var meiliErr *Error
+	require.ErrorAs(t, err, &meiliErr)
+	require.Equal(t, APIErrorCodeIndexNotFound, meiliErr.MeilisearchApiError.Code)
+}
+
+func TestErrorPredicates(t *testing.T) {
+	apiError := func(statusCode int, code APIErrorCode) error {
+		return (&Error{
+			StatusCode:          statusCode,
+			MeilisearchApiError: APIError{Code: code},
+		}).WithErrCode(MeilisearchApiError)
+	}
+
+	tests := []struct {
+		name          string
+		err           error
+		wantNotFound  bool
+		wantAuthError bool
+		wantRetryable bool
+	}{
+		{
+			name:         "Document not found",
+			err:          apiError(http.StatusNotFound, APIErrorCodeDocumentNotFound),
+			wantNotFound: true,
+		},
+		{
+			name:         "Wrapped not found status without code",
+			err:          fmt.Errorf("get document: %w", (&Error{StatusCode: http.StatusNotFound}).WithErrCode(MeilisearchApiErrorWithoutMessage)),
+			wantNotFound: true,
+		},
+		{
+			name:          "Invalid api key",
+			err:           apiError(http.StatusForbidden, APIErrorCodeInvalidAPIKey),
+			wantAuthError: true,
+		},
+		{
+			name:          "Missing authorization header",
+			err:           apiError(http.StatusUnauthorized, APIErrorCodeMissingAuthorizationHeader),
+			wantAuthError: true,
+		},
+		{
+			name:          "Too many search requests",
+			err:           apiError(http.StatusServiceUnavailable, APIErrorCodeTooManySearchRequests),
+			wantRetryable: true,
+		},
+		{
+			name:          "Bad gateway",
+			err:           (&Error{StatusCode: http.StatusBadGateway}).WithErrCode(MeilisearchApiErrorWithoutMessage),
+			wantRetryable: true,
+		},
+		{
+			name:          "Communication error",
+			err:           (&Error{}).WithErrCode(MeilisearchCommunicationError, errors.New("connection refused")),
+			wantRetryable: true,
+		},
+		{
+			name: "Canceled request",
+			err:  (&Error{}).WithErrCode(MeilisearchCommunicationError, context.Canceled),
+		},
+		{
+			name: "Invalid filter",
+			err:  apiError(http.StatusBadRequest, APIErrorCodeInvalidSearchFilter),
+		},
+		{
+			name: "Not a meilisearch error",
+			err:  errors.New("boom"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantNotFound, IsNotFound(tt.err))
+			require.Equal(t, tt.wantAuthError, IsAuthError(tt.err))
+			require.Equal(t, tt.wantRetryable, IsRetryable(tt.err))
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Attribute builds the conditions on an attribute, nested attributes are separated by dots,
+// e.g. Attr("release.year").Gte(2000).
+type Attribute string
+
+// Attr returns the builder of the conditions on the attribute name.
+func Attr(name string) Attribute {
+	return Attribute(name)
+}
+
+// Eq matches the documents whose attribute is equal to value.
+func (a Attribute) Eq(value interface{}) Condition {
+	return Condition{Attribute: string(a), Operator: Equal, Value: NewValue(value)}
+}
+
+// Ne matches the documents whose attribute is not equal to value.
+func (a Attribute) Ne(value interface{}) Condition {
+	return Condition{Attribute: string(a), Operator: NotEqual, Value: NewValue(value)}
+}
+
+// Gt matches the documents whose attribute is greater than value.
+func (a Attribute) Gt(value interface{}) Condition {
+	return Condition{Attribute: string(a), Operator: GreaterThan, Value: NewValue(value)}
+}
+
+// Gte matches the documents whose attribute is greater than or equal to value.
+func (a Attribute) Gte(value interface{}) Condition {
+	return Condition{Attribute: string(a), Operator: GreaterThanOrEqual, Value: NewValue(value)}
+}
+
+// Lt matches the documents whose attribute is lower than value.
+func (a Attribute) Lt(value interface{}) Condition {
+	return Condition{Attribute: string(a), Operator: LowerThan, Value: NewValue(value)}
+}
+
+// Lte matches the documents whose attribute is lower than or equal to value.
+func (a Attribute) Lte(value interface{}) Condition {
+	return Condition{Attribute: string(a), Operator: LowerThanOrEqual, Value: NewValue(value)}
+}
+
+// To matches the documents whose attribute is between from and to included.
+func (a Attribute) To(from, to interface{}) Range {
+	return Range{Attribute: string(a), From: NewValue(from), To: NewValue(to)}
+}
+
+// In matches the documents whose attribute is equal to one of values.
+func (a Attribute) In(values ...interface{}) In {
+	return In{Attribute: string(a), Values: newValues(values)}
+}
+
+// NotIn matches the documents whose attribute is equal to none of values.
+func (a Attribute) NotIn(values ...interface{}) In {
+	return In{Attribute: string(a), Values: newValues(values), Negated: true}
+}
+
+// Exists matches the documents having the attribute, even null or empty.
+func (a Attribute) Exists() Exists {
+	return Exists{Attribute: string(a)}
+}
+
+// NotExists matches the documents not having the attribute.
+func (a Attribute) NotExists() Exists {
+	return Exists{Attribute: string(a), Negated: true}
+}
+
+// IsNull matches the documents whose attribute is null.
+func (a Attribute) IsNull() IsNull {
+	return IsNull{Attribute: string(a)}
+}
+
+// IsNotNull matches the documents whose attribute is not null.
+func (a Attribute) IsNotNull() IsNull {
+	return IsNull{Attribute: string(a), Negated: true}
+}
+
+// IsEmpty matches the documents whose attribute is an empty string, array or object.
+func (a Attribute) IsEmpty() IsEmpty {
+	return IsEmpty{Attribute: string(a)}
+}
+
+// IsNotEmpty matches the documents whose attribute is not empty.
+func (a Attribute) IsNotEmpty() IsEmpty {
+	return IsEmpty{Attribute: string(a), Negated: true}
+}
+
+// Contains matches the documents whose attribute contains value as a substring.
+func (a Attribute) Contains(value interface{}) Contains {
+	return Contains{Attribute: string(a), Value: NewValue(value)}
+}
+
+// NotContains matches the documents whose attribute does not contain value.
+func (a Attribute) NotContains(value interface{}) Contains {
+	return Contains{Attribute: string(a), Value: NewValue(value), Negated: true}
+}
+
+// StartsWith matches the documents whose attribute starts with value.
+func (a Attribute) StartsWith(value interface{}) StartsWith {
+	return StartsWith{Attribute: string(a), Value: NewValue(value)}
+}
+
+// NotStartsWith matches the documents whose attribute does not start with value.
+func (a Attribute) NotStartsWith(value interface{}) StartsWith {
+	return StartsWith{Attribute: string(a), Value: NewValue(value), Negated: true}
+}
+
+// And matches the documents matched by all of exprs.
+func And(exprs ...Expr) AndExpr {
+	return AndExpr{Exprs: exprs}
+}
+
+// Or matches the documents matched by any of exprs.
+func Or(exprs ...Expr) OrExpr {
+	return OrExpr{Exprs: exprs}
+}
+
+// Not matches the documents not matched by expr.
+func Not(expr Expr) NotExpr {
+	return NotExpr{Expr: expr}
+}
+
+// GeoRadius matches the documents whose _geo is within distance meters of the point lat, lng.
+func GeoRadius(lat, lng, distance float64) GeoRadiusExpr {
+	return GeoRadiusExpr{Lat: lat, Lng: lng, Distance: distance}
+}
+
+// GeoBoundingBox matches the documents whose _geo is within the rectangle whose top left corner
+// is topLeftLat, topLeftLng and bottom right corner is bottomRightLat, bottomRightLng.
+func GeoBoundingBox(topLeftLat, topLeftLng, bottomRightLat, bottomRightLng float64) GeoBoundingBoxExpr {
+	return GeoBoundingBoxExpr{
+		TopLeftLat:     topLeftLat,
+		TopLeftLng:     topLeftLng,
+		BottomRightLat: bottomRightLat,
+		BottomRightLng: bottomRightLng,
+	}
+}
+
+// GeoPolygon matches the documents whose _geo is within the polygon of points, each given by
+// its latitude and longitude.
+func GeoPolygon(points ...[2]float64) GeoPolygonExpr {
+	return GeoPolygonExpr{Points: points}
+}
+
+// NewValue converts value to a Value. The numbers and booleans are rendered as is, the strings
+// and any other value formatted with fmt are quoted.
+func NewValue(value interface{}) Value {
+	switch v := value.(type) {
+	case Value:
+		return v
+	case string:
+		return Value{Raw: v, Quoted: true}
+	case bool:
+		return Value{Raw: strconv.FormatBool(v)}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return Value{Raw: fmt.Sprint(v)}
+	case float32:
+		return Value{Raw: strconv.FormatFloat(float64(v), 'f', -1, 32)}
+	case float64:
+		return Value{Raw: strconv.FormatFloat(v, 'f', -1, 64)}
+	}
+	return Value{Raw: fmt.Sprint(value), Quoted: true}
+}
+
+func newValues(values []interface{}) []Value {
+	converted := make([]Value, len(values))
+	for i, value := range values {
+		converted[i] = NewValue(value)
+	}
+	return converted
+}
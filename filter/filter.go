@@ -0,0 +1,464 @@
+// Package filter builds Meilisearch filter expressions, rendering the attributes and values
+// quoted and escaped so that user input cannot change the meaning of a filter.
+//
+// An expression is a tree of Expr. It renders with String, and marshals to a JSON string so
+// that it can be given as is to the Filter of a SearchRequest, a DocumentsQuery or
+// DeleteDocumentsByFilter. Its String goes to the string filters of a FacetSearchRequest or a
+// SimilarDocumentQuery.
+//
+//	Example:
+//
+//	f := filter.And(
+//		filter.Attr("genres").In("Drama", "Action"),
+//		filter.Attr("rating").Gte(4.5),
+//		filter.Not(filter.Attr("director").Eq(`Ryan "Rian" Johnson`)),
+//	)
+//	f.String() // genres IN ["Drama", "Action"] AND rating >= 4.5 AND NOT director = "Ryan \"Rian\" Johnson"
+//
+//	resp, err := idx.Search("", &meilisearch.SearchRequest{Filter: f})
+//
+// Parse reads an existing filter into an Expr, and Validate checks that its attributes are
+// filterable attributes of an index before it is sent to Meilisearch.
+package filter
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Expr is an expression of a filter.
+type Expr interface {
+	// String renders the expression in the Meilisearch filter syntax. An attribute or a value
+	// that cannot be quoted, see QuoteError, renders as ")" so that the filter is rejected
+	// rather than its meaning changed.
+	String() string
+
+	// MarshalJSON renders the expression as a JSON string, a *QuoteError is returned when one
+	// of its attributes or values cannot be quoted.
+	MarshalJSON() ([]byte, error)
+
+	isExpr()
+}
+
+// QuoteError is returned when marshaling an expression holding an attribute or a value that no
+// quoting can represent. A backslash escapes the character following it in a quoted string of a
+// filter, and Meilisearch unescapes the quotes only: a string ending with an odd number of
+// backslashes, or with an odd number of backslashes before both a double and a single quote,
+// would escape its own quotes.
+type QuoteError struct {
+	Value string
+}
+
+func (e *QuoteError) Error() string {
+	return "filter: " + strconv.Quote(e.Value) + " cannot be quoted, its backslashes would escape the quotes"
+}
+
+// Operator compares an attribute to a value in a Condition.
+type Operator string
+
+const (
+	Equal              Operator = "="
+	NotEqual           Operator = "!="
+	GreaterThan        Operator = ">"
+	GreaterThanOrEqual Operator = ">="
+	LowerThan          Operator = "<"
+	LowerThanOrEqual   Operator = "<="
+)
+
+// Value is a value compared to an attribute.
+type Value struct {
+	// Raw is the value, without quotes nor escaping.
+	Raw string
+
+	// Quoted renders the value between quotes. The values that are not numbers are always
+	// quoted, as they may contain spaces, quotes or keywords.
+	Quoted bool
+}
+
+// String renders the value, quoted when needed.
+func (v Value) String() string {
+	if v.Quoted || !isBare(v.Raw) {
+		return quote(v.Raw)
+	}
+	return v.Raw
+}
+
+// Condition compares an attribute to a value, e.g. rating >= 4.
+type Condition struct {
+	Attribute string
+	Operator  Operator
+	Value     Value
+}
+
+// Range matches the values of an attribute between From and To included, e.g. year 1990 TO 2000.
+type Range struct {
+	Attribute string
+	From      Value
+	To        Value
+}
+
+// In matches the attributes equal to one of the values, e.g. genres IN [Drama, Action].
+type In struct {
+	Attribute string
+	Values    []Value
+	Negated   bool
+}
+
+// Exists matches the documents having the attribute, e.g. release_date EXISTS.
+type Exists struct {
+	Attribute string
+	Negated   bool
+}
+
+// IsNull matches the attributes whose value is null, e.g. director IS NULL.
+type IsNull struct {
+	Attribute string
+	Negated   bool
+}
+
+// IsEmpty matches the attributes whose value is an empty string, array or object, e.g.
+// genres IS EMPTY.
+type IsEmpty struct {
+	Attribute string
+	Negated   bool
+}
+
+// Contains matches the attributes containing the value as a substring, e.g.
+// title CONTAINS "carol".
+type Contains struct {
+	Attribute string
+	Value     Value
+	Negated   bool
+}
+
+// StartsWith matches the attributes starting with the value, e.g. title STARTS WITH "car".
+type StartsWith struct {
+	Attribute string
+	Value     Value
+	Negated   bool
+}
+
+// AndExpr matches the documents matched by all of Exprs.
+type AndExpr struct {
+	Exprs []Expr
+}
+
+// OrExpr matches the documents matched by any of Exprs.
+type OrExpr struct {
+	Exprs []Expr
+}
+
+// NotExpr matches the documents not matched by Expr.
+type NotExpr struct {
+	Expr Expr
+}
+
+// GeoRadiusExpr matches the documents whose _geo is within Distance meters of a point.
+type GeoRadiusExpr struct {
+	Lat      float64
+	Lng      float64
+	Distance float64
+}
+
+// GeoBoundingBoxExpr matches the documents whose _geo is within a rectangle, given by its top left
+// and bottom right corners.
+type GeoBoundingBoxExpr struct {
+	TopLeftLat     float64
+	TopLeftLng     float64
+	BottomRightLat float64
+	BottomRightLng float64
+}
+
+// GeoPolygonExpr matches the documents whose _geo is within a polygon, given by the latitude and
+// longitude of at least 3 of its points.
+type GeoPolygonExpr struct {
+	Points [][2]float64
+}
+
+func (e Condition) String() string {
+	return attribute(e.Attribute) + " " + string(e.Operator) + " " + e.Value.String()
+}
+
+func (e Range) String() string {
+	return attribute(e.Attribute) + " " + e.From.String() + " TO " + e.To.String()
+}
+
+func (e In) String() string {
+	values := make([]string, len(e.Values))
+	for i, value := range e.Values {
+		values[i] = value.String()
+	}
+	return attribute(e.Attribute) + negated(e.Negated, " IN [", " NOT IN [") + strings.Join(values, ", ") + "]"
+}
+
+func (e Exists) String() string {
+	return attribute(e.Attribute) + negated(e.Negated, " EXISTS", " NOT EXISTS")
+}
+
+func (e IsNull) String() string {
+	return attribute(e.Attribute) + negated(e.Negated, " IS NULL", " IS NOT NULL")
+}
+
+func (e IsEmpty) String() string {
+	return attribute(e.Attribute) + negated(e.Negated, " IS EMPTY", " IS NOT EMPTY")
+}
+
+func (e Contains) String() string {
+	return attribute(e.Attribute) + negated(e.Negated, " CONTAINS ", " NOT CONTAINS ") + e.Value.String()
+}
+
+func (e StartsWith) String() string {
+	return attribute(e.Attribute) + negated(e.Negated, " STARTS WITH ", " NOT STARTS WITH ") + e.Value.String()
+}
+
+func (e AndExpr) String() string {
+	return join(e.Exprs, " AND ", func(expr Expr) bool {
+		_, ok := expr.(OrExpr)
+		return ok
+	})
+}
+
+func (e OrExpr) String() string {
+	return join(e.Exprs, " OR ", func(Expr) bool {
+		return false
+	})
+}
+
+func (e NotExpr) String() string {
+	if e.Expr == nil {
+		return ""
+	}
+	inner := e.Expr.String()
+	if inner == "" {
+		return ""
+	}
+	switch e.Expr.(type) {
+	case AndExpr, OrExpr:
+		return "NOT (" + inner + ")"
+	}
+	return "NOT " + inner
+}
+
+func (e GeoRadiusExpr) String() string {
+	return "_geoRadius(" + formatFloat(e.Lat) + ", " + formatFloat(e.Lng) + ", " + formatFloat(e.Distance) + ")"
+}
+
+func (e GeoBoundingBoxExpr) String() string {
+	return "_geoBoundingBox([" + formatFloat(e.TopLeftLat) + ", " + formatFloat(e.TopLeftLng) + "], [" +
+		formatFloat(e.BottomRightLat) + ", " + formatFloat(e.BottomRightLng) + "])"
+}
+
+func (e GeoPolygonExpr) String() string {
+	points := make([]string, len(e.Points))
+	for i, point := range e.Points {
+		points[i] = "[" + formatFloat(point[0]) + ", " + formatFloat(point[1]) + "]"
+	}
+	return "_geoPolygon(" + strings.Join(points, ", ") + ")"
+}
+
+func (e Condition) MarshalJSON() ([]byte, error)          { return marshal(e) }
+func (e Range) MarshalJSON() ([]byte, error)              { return marshal(e) }
+func (e In) MarshalJSON() ([]byte, error)                 { return marshal(e) }
+func (e Exists) MarshalJSON() ([]byte, error)             { return marshal(e) }
+func (e IsNull) MarshalJSON() ([]byte, error)             { return marshal(e) }
+func (e IsEmpty) MarshalJSON() ([]byte, error)            { return marshal(e) }
+func (e Contains) MarshalJSON() ([]byte, error)           { return marshal(e) }
+func (e StartsWith) MarshalJSON() ([]byte, error)         { return marshal(e) }
+func (e AndExpr) MarshalJSON() ([]byte, error)            { return marshal(e) }
+func (e OrExpr) MarshalJSON() ([]byte, error)             { return marshal(e) }
+func (e NotExpr) MarshalJSON() ([]byte, error)            { return marshal(e) }
+func (e GeoRadiusExpr) MarshalJSON() ([]byte, error)      { return marshal(e) }
+func (e GeoBoundingBoxExpr) MarshalJSON() ([]byte, error) { return marshal(e) }
+func (e GeoPolygonExpr) MarshalJSON() ([]byte, error)     { return marshal(e) }
+
+func (Condition) isExpr()          {}
+func (Range) isExpr()              {}
+func (In) isExpr()                 {}
+func (Exists) isExpr()             {}
+func (IsNull) isExpr()             {}
+func (IsEmpty) isExpr()            {}
+func (Contains) isExpr()           {}
+func (StartsWith) isExpr()         {}
+func (AndExpr) isExpr()            {}
+func (OrExpr) isExpr()             {}
+func (NotExpr) isExpr()            {}
+func (GeoRadiusExpr) isExpr()      {}
+func (GeoBoundingBoxExpr) isExpr() {}
+func (GeoPolygonExpr) isExpr()     {}
+
+// marshal renders expr as a JSON string, failing when one of its attributes or values cannot be
+// quoted.
+func marshal(expr Expr) ([]byte, error) {
+	if err := checkQuotes(expr); err != nil {
+		return nil, err
+	}
+	return json.Marshal(expr.String())
+}
+
+// checkQuotes returns a *QuoteError for the first attribute or value of expr that cannot be
+// quoted.
+func checkQuotes(expr Expr) error {
+	var strs []string
+	switch e := expr.(type) {
+	case Condition:
+		strs = []string{e.Attribute, e.Value.Raw}
+	case Range:
+		strs = []string{e.Attribute, e.From.Raw, e.To.Raw}
+	case In:
+		strs = []string{e.Attribute}
+		for _, value := range e.Values {
+			strs = append(strs, value.Raw)
+		}
+	case Exists:
+		strs = []string{e.Attribute}
+	case IsNull:
+		strs = []string{e.Attribute}
+	case IsEmpty:
+		strs = []string{e.Attribute}
+	case Contains:
+		strs = []string{e.Attribute, e.Value.Raw}
+	case StartsWith:
+		strs = []string{e.Attribute, e.Value.Raw}
+	case AndExpr:
+		return checkQuotesAll(e.Exprs)
+	case OrExpr:
+		return checkQuotesAll(e.Exprs)
+	case NotExpr:
+		return checkQuotes(e.Expr)
+	}
+	for _, s := range strs {
+		if _, ok := quoteWith(s); !ok {
+			return &QuoteError{Value: s}
+		}
+	}
+	return nil
+}
+
+func checkQuotesAll(exprs []Expr) error {
+	for _, expr := range exprs {
+		if err := checkQuotes(expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// join renders exprs separated by sep, the ones for which parenthesize returns true between
+// parentheses. The empty expressions are skipped.
+func join(exprs []Expr, sep string, parenthesize func(Expr) bool) string {
+	parts := make([]string, 0, len(exprs))
+	for _, expr := range exprs {
+		if expr == nil {
+			continue
+		}
+		part := expr.String()
+		if part == "" {
+			continue
+		}
+		if parenthesize(expr) {
+			part = "(" + part + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, sep)
+}
+
+func negated(negated bool, positive, negative string) string {
+	if negated {
+		return negative
+	}
+	return positive
+}
+
+// keywords are the words of the filter syntax, an attribute or a value named after one of them
+// is quoted.
+var keywords = map[string]bool{
+	"AND":             true,
+	"OR":              true,
+	"NOT":             true,
+	"TO":              true,
+	"IN":              true,
+	"EXISTS":          true,
+	"IS":              true,
+	"NULL":            true,
+	"EMPTY":           true,
+	"CONTAINS":        true,
+	"STARTS":          true,
+	"WITH":            true,
+	"_geoRadius":      true,
+	"_geoBoundingBox": true,
+	"_geoPolygon":     true,
+}
+
+// isBare reports whether s can be rendered without quotes, it is then made of letters, digits,
+// and the characters _ - . only and is not a keyword.
+func isBare(s string) bool {
+	if s == "" || keywords[strings.ToUpper(s)] || keywords[s] {
+		return false
+	}
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-', r == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// attribute renders the name of an attribute, quoted when needed.
+func attribute(name string) string {
+	if isBare(name) {
+		return name
+	}
+	return quote(name)
+}
+
+// unquotable is rendered in place of the strings that cannot be quoted, it is not a valid
+// attribute nor value and makes Meilisearch reject the filter.
+const unquotable = ")"
+
+// quote renders s between quotes, or unquotable when it cannot be quoted.
+func quote(s string) string {
+	if quoted, ok := quoteWith(s); ok {
+		return quoted
+	}
+	return unquotable
+}
+
+// quoteWith renders s between double quotes, or single quotes when its backslashes would escape
+// the double quotes, escaping the quotes it contains with a backslash. ok is false when s
+// cannot be quoted with either.
+func quoteWith(s string) (quoted string, ok bool) {
+	for _, q := range []string{`"`, `'`} {
+		if quotable(s, q[0]) {
+			return q + strings.ReplaceAll(s, q, `\`+q) + q, true
+		}
+	}
+	return "", false
+}
+
+// quotable reports whether s can be quoted with q. Every run of backslashes followed by q or
+// ending s must have an even length, once the quotes escaped an odd one would escape the quote
+// following it.
+func quotable(s string, q byte) bool {
+	run := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			run++
+			continue
+		case q:
+			if run%2 == 1 {
+				return false
+			}
+		}
+		run = 0
+	}
+	return run%2 == 0
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
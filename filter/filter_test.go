@@ -0,0 +1,136 @@
+package filter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpr_String(t *testing.T) {
+	tests := []struct {
+		name string
+		expr Expr
+		want string
+	}{
+		{
+			name: "Equal",
+			expr: Attr("genres").Eq("Drama"),
+			want: `genres = "Drama"`,
+		},
+		{
+			name: "Not equal number",
+			expr: Attr("id").Ne(42),
+			want: `id != 42`,
+		},
+		{
+			name: "Comparisons",
+			expr: And(Attr("rating").Gt(4.5), Attr("rating").Gte(float32(0.1)), Attr("year").Lt(int64(2000)), Attr("year").Lte(uint8(3))),
+			want: `rating > 4.5 AND rating >= 0.1 AND year < 2000 AND year <= 3`,
+		},
+		{
+			name: "Boolean",
+			expr: Attr("available").Eq(true),
+			want: `available = true`,
+		},
+		{
+			name: "Range",
+			expr: Attr("release.year").To(1990, 2000),
+			want: `release.year 1990 TO 2000`,
+		},
+		{
+			name: "In",
+			expr: Attr("genres").In("Drama", "Science Fiction", 3),
+			want: `genres IN ["Drama", "Science Fiction", 3]`,
+		},
+		{
+			name: "Not in",
+			expr: Attr("genres").NotIn("Horror"),
+			want: `genres NOT IN ["Horror"]`,
+		},
+		{
+			name: "Exists",
+			expr: Or(Attr("poster").Exists(), Attr("poster").NotExists()),
+			want: `poster EXISTS OR poster NOT EXISTS`,
+		},
+		{
+			name: "Null and empty",
+			expr: And(Attr("director").IsNull(), Attr("director").IsNotNull(), Attr("genres").IsEmpty(), Attr("genres").IsNotEmpty()),
+			want: `director IS NULL AND director IS NOT NULL AND genres IS EMPTY AND genres IS NOT EMPTY`,
+		},
+		{
+			name: "Quotes and injection",
+			expr: Attr("title").Eq(`Carol" OR id EXISTS OR title = "`),
+			want: `title = "Carol\" OR id EXISTS OR title = \""`,
+		},
+		{
+			name: "Backslashes",
+			expr: Or(Attr("path").Eq(`C:\movies\\`), Attr("title").Eq(`\"Carol\" it's`)),
+			want: `path = "C:\movies\\" OR title = '\"Carol\" it\'s'`,
+		},
+		{
+			name: "Unquotable value",
+			expr: Attr("path").Eq(`C:\movies\`),
+			want: `path = )`,
+		},
+		{
+			name: "Quoted attribute",
+			expr: And(Attr("release date").Eq("2024"), Attr("TO").Exists(), Attr("").IsNull()),
+			want: `"release date" = "2024" AND "TO" EXISTS AND "" IS NULL`,
+		},
+		{
+			name: "Bare value keyword",
+			expr: Attr("status").Eq(Value{Raw: "AND"}),
+			want: `status = "AND"`,
+		},
+		{
+			name: "Precedence",
+			expr: And(Or(Attr("a").Eq(1), Attr("b").Eq(2)), Not(And(Attr("c").Eq(3), Attr("d").Eq(4))), Not(Attr("e").Exists())),
+			want: `(a = 1 OR b = 2) AND NOT (c = 3 AND d = 4) AND NOT e EXISTS`,
+		},
+		{
+			name: "Or of and",
+			expr: Or(And(Attr("a").Eq(1), Attr("b").Eq(2)), Attr("c").Eq(3)),
+			want: `a = 1 AND b = 2 OR c = 3`,
+		},
+		{
+			name: "Empty expressions",
+			expr: And(nil, Or(), Not(And()), Attr("a").Eq(1)),
+			want: `a = 1`,
+		},
+		{
+			name: "Contains and starts with",
+			expr: And(Attr("title").Contains("carol"), Attr("title").NotContains("the"), Attr("director").StartsWith("Todd"), Attr("director").NotStartsWith("Ryan")),
+			want: `title CONTAINS "carol" AND title NOT CONTAINS "the" AND director STARTS WITH "Todd" AND director NOT STARTS WITH "Ryan"`,
+		},
+		{
+			name: "Geo radius",
+			expr: GeoRadius(45.472735, 9.184019, 2000),
+			want: `_geoRadius(45.472735, 9.184019, 2000)`,
+		},
+		{
+			name: "Geo bounding box",
+			expr: GeoBoundingBox(45.494181, 9.214024, 45.449484, 9.179175),
+			want: `_geoBoundingBox([45.494181, 9.214024], [45.449484, 9.179175])`,
+		},
+		{
+			name: "Geo polygon",
+			expr: GeoPolygon([2]float64{45.494181, 9.214024}, [2]float64{45.449484, 9.179175}, [2]float64{45.472735, 9.184019}),
+			want: `_geoPolygon([45.494181, 9.214024], [45.449484, 9.179175], [45.472735, 9.184019])`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.expr.String())
+		})
+	}
+}
+
+func TestExpr_MarshalJSON(t *testing.T) {
+	expr := And(Attr("title").Eq(`Carol "2015"`), GeoRadius(1, 2, 3))
+
+	b, err := json.Marshal(expr)
+	require.NoError(t, err)
+	require.JSONEq(t, `"title = \"Carol \\\"2015\\\"\" AND _geoRadius(1, 2, 3)"`, string(b))
+}
@@ -0,0 +1,17 @@
+package filter_test
+
+import (
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/meilisearch/meilisearch-go/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpr_SearchRequest(t *testing.T) {
+	expr := filter.And(filter.Attr("title").Eq(`Carol "2015"`), filter.GeoRadius(1, 2, 3))
+
+	b, err := (&meilisearch.SearchRequest{Filter: expr}).MarshalJSON()
+	require.NoError(t, err)
+	require.Contains(t, string(b), `"filter":"title = \"Carol \\\"2015\\\"\" AND _geoRadius(1, 2, 3)"`)
+}
@@ -0,0 +1,493 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SyntaxError is returned by Parse for a filter that is not valid.
+type SyntaxError struct {
+	// Filter is the parsed filter.
+	Filter string
+
+	// Pos is the byte offset in Filter where the error was found.
+	Pos int
+
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("filter: %s at position %d", e.Msg, e.Pos)
+}
+
+// Parse parses a filter written in the Meilisearch filter syntax into an Expr. The keywords
+// are matched regardless of their case. A *SyntaxError is returned for an invalid filter,
+// Parse of an empty filter returns nil.
+func Parse(s string) (Expr, error) {
+	tokens, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{filter: s, tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, nil
+	}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.errorf(tok, "unexpected %s, expected AND, OR or the end of the filter", tok)
+	}
+	return expr, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenLeftBracket
+	tokenRightBracket
+	tokenComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of filter"
+	case tokenString:
+		return "string " + quote(t.text)
+	}
+	return strconv.Quote(t.text)
+}
+
+// is reports whether the token is the unquoted keyword.
+func (t token) is(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+// isValue reports whether the token can be an attribute or a value, the keywords have to be
+// quoted to be used as such.
+func (t token) isValue() bool {
+	return t.kind == tokenString || (t.kind == tokenWord && !keywords[strings.ToUpper(t.text)] && !keywords[t.text])
+}
+
+func (t token) value() Value {
+	return Value{Raw: t.text, Quoted: t.kind == tokenString}
+}
+
+var punctuation = map[byte]tokenKind{
+	'(': tokenLeftParen,
+	')': tokenRightParen,
+	'[': tokenLeftBracket,
+	']': tokenRightBracket,
+	',': tokenComma,
+}
+
+func lex(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case punctuation[c] != tokenEOF:
+			tokens = append(tokens, token{kind: punctuation[c], text: string(c), pos: i})
+			i++
+		case c == '=' || c == '<' || c == '>' || c == '!':
+			end := i + 1
+			if end < len(s) && s[end] == '=' && c != '=' {
+				end++
+			}
+			if s[i:end] == "!" {
+				return nil, &SyntaxError{Filter: s, Pos: i, Msg: `unexpected "!", expected "!="`}
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: s[i:end], pos: i})
+			i = end
+		case c == '"' || c == '\'':
+			text, end, ok := lexString(s, i)
+			if !ok {
+				return nil, &SyntaxError{Filter: s, Pos: i, Msg: "unterminated string"}
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: i})
+			i = end
+		default:
+			end := i
+			for end < len(s) && !strings.ContainsRune(" \t\n\r()[],=<>!\"'", rune(s[end])) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: s[i:end], pos: i})
+			i = end
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(s)}), nil
+}
+
+// lexString reads the string starting with the quote at s[start] as Meilisearch does: a
+// backslash escapes the character following it, and only an escaped quote is unescaped, the
+// other backslashes are kept. The unescaped string and the offset following the closing quote are
+// returned, ok is false when the string is not terminated.
+func lexString(s string, start int) (text string, end int, ok bool) {
+	q := s[start]
+	var b strings.Builder
+	for i := start + 1; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			if s[i+1] != q {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(s[i+1])
+			i++
+		case s[i] == q:
+			return b.String(), i + 1, true
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", 0, false
+}
+
+type parser struct {
+	filter string
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) errorf(tok token, format string, args ...interface{}) error {
+	return &SyntaxError{Filter: p.filter, Pos: tok.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) expect(kind tokenKind, text string) (token, error) {
+	tok := p.next()
+	if tok.kind != kind {
+		return tok, p.errorf(tok, "unexpected %s, expected %q", tok, text)
+	}
+	return tok, nil
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	expr, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	exprs := []Expr{expr}
+	for p.peek().is("OR") {
+		p.next()
+		expr, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return OrExpr{Exprs: exprs}, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	expr, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	exprs := []Expr{expr}
+	for p.peek().is("AND") {
+		p.next()
+		expr, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return AndExpr{Exprs: exprs}, nil
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if p.peek().is("NOT") {
+		p.next()
+		expr, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return NotExpr{Expr: expr}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	tok := p.peek()
+	switch {
+	case tok.kind == tokenLeftParen:
+		p.next()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	case tok.kind == tokenWord && tok.text == "_geoRadius":
+		p.next()
+		return p.parseGeoRadius()
+	case tok.kind == tokenWord && tok.text == "_geoBoundingBox":
+		p.next()
+		return p.parseGeoBoundingBox()
+	case tok.kind == tokenWord && tok.text == "_geoPolygon":
+		p.next()
+		return p.parseGeoPolygon()
+	case tok.kind == tokenWord && tok.text == "_geo":
+		return nil, p.errorf(tok, "_geo is reserved, filter with _geoRadius, _geoBoundingBox or _geoPolygon instead")
+	case tok.isValue():
+		p.next()
+		return p.parseCondition(tok.text)
+	}
+	return nil, p.errorf(tok, "unexpected %s, expected an attribute, NOT or (", tok)
+}
+
+func (p *parser) parseCondition(attribute string) (Expr, error) {
+	tok := p.next()
+	switch {
+	case tok.kind == tokenOperator:
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return Condition{Attribute: attribute, Operator: Operator(tok.text), Value: value}, nil
+	case tok.isValue():
+		if to := p.next(); !to.is("TO") {
+			return nil, p.errorf(to, "unexpected %s, expected TO", to)
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return Range{Attribute: attribute, From: tok.value(), To: value}, nil
+	case tok.is("IN"):
+		values, err := p.parseValues()
+		if err != nil {
+			return nil, err
+		}
+		return In{Attribute: attribute, Values: values}, nil
+	case tok.is("EXISTS"):
+		return Exists{Attribute: attribute}, nil
+	case tok.is("CONTAINS"):
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return Contains{Attribute: attribute, Value: value}, nil
+	case tok.is("STARTS"):
+		return p.parseStartsWith(attribute, false)
+	case tok.is("NOT"):
+		switch next := p.next(); {
+		case next.is("IN"):
+			values, err := p.parseValues()
+			if err != nil {
+				return nil, err
+			}
+			return In{Attribute: attribute, Values: values, Negated: true}, nil
+		case next.is("EXISTS"):
+			return Exists{Attribute: attribute, Negated: true}, nil
+		case next.is("CONTAINS"):
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			return Contains{Attribute: attribute, Value: value, Negated: true}, nil
+		case next.is("STARTS"):
+			return p.parseStartsWith(attribute, true)
+		default:
+			return nil, p.errorf(next, "unexpected %s, expected IN, EXISTS, CONTAINS or STARTS WITH", next)
+		}
+	case tok.is("IS"):
+		negated := false
+		next := p.next()
+		if next.is("NOT") {
+			negated = true
+			next = p.next()
+		}
+		switch {
+		case next.is("NULL"):
+			return IsNull{Attribute: attribute, Negated: negated}, nil
+		case next.is("EMPTY"):
+			return IsEmpty{Attribute: attribute, Negated: negated}, nil
+		default:
+			return nil, p.errorf(next, "unexpected %s, expected NULL or EMPTY", next)
+		}
+	}
+	return nil, p.errorf(tok, "unexpected %s, expected an operator, TO, IN, EXISTS, IS, CONTAINS or STARTS WITH after the attribute", tok)
+}
+
+// parseStartsWith parses the WITH and the value following STARTS.
+func (p *parser) parseStartsWith(attribute string, negated bool) (Expr, error) {
+	if with := p.next(); !with.is("WITH") {
+		return nil, p.errorf(with, "unexpected %s, expected WITH", with)
+	}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	return StartsWith{Attribute: attribute, Value: value, Negated: negated}, nil
+}
+
+func (p *parser) parseValue() (Value, error) {
+	tok := p.next()
+	if !tok.isValue() {
+		return Value{}, p.errorf(tok, "unexpected %s, expected a value", tok)
+	}
+	return tok.value(), nil
+}
+
+// parseValues parses the list of values following IN.
+func (p *parser) parseValues() ([]Value, error) {
+	if _, err := p.expect(tokenLeftBracket, "["); err != nil {
+		return nil, err
+	}
+	var values []Value
+	for p.peek().kind != tokenRightBracket {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		if p.peek().kind != tokenComma {
+			break
+		}
+		p.next()
+	}
+	if _, err := p.expect(tokenRightBracket, "]"); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func (p *parser) parseGeoRadius() (Expr, error) {
+	if _, err := p.expect(tokenLeftParen, "("); err != nil {
+		return nil, err
+	}
+	numbers, err := p.parseNumbers(3)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenRightParen, ")"); err != nil {
+		return nil, err
+	}
+	return GeoRadiusExpr{Lat: numbers[0], Lng: numbers[1], Distance: numbers[2]}, nil
+}
+
+func (p *parser) parseGeoBoundingBox() (Expr, error) {
+	if _, err := p.expect(tokenLeftParen, "("); err != nil {
+		return nil, err
+	}
+	var corners [2][]float64
+	for i := range corners {
+		if i > 0 {
+			if _, err := p.expect(tokenComma, ","); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := p.expect(tokenLeftBracket, "["); err != nil {
+			return nil, err
+		}
+		numbers, err := p.parseNumbers(2)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightBracket, "]"); err != nil {
+			return nil, err
+		}
+		corners[i] = numbers
+	}
+	if _, err := p.expect(tokenRightParen, ")"); err != nil {
+		return nil, err
+	}
+	return GeoBoundingBoxExpr{
+		TopLeftLat:     corners[0][0],
+		TopLeftLng:     corners[0][1],
+		BottomRightLat: corners[1][0],
+		BottomRightLng: corners[1][1],
+	}, nil
+}
+
+func (p *parser) parseGeoPolygon() (Expr, error) {
+	if _, err := p.expect(tokenLeftParen, "("); err != nil {
+		return nil, err
+	}
+	var points [][2]float64
+	for {
+		if len(points) > 0 {
+			if p.peek().kind != tokenComma {
+				break
+			}
+			p.next()
+		}
+		if _, err := p.expect(tokenLeftBracket, "["); err != nil {
+			return nil, err
+		}
+		numbers, err := p.parseNumbers(2)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightBracket, "]"); err != nil {
+			return nil, err
+		}
+		points = append(points, [2]float64{numbers[0], numbers[1]})
+	}
+	tok, err := p.expect(tokenRightParen, ")")
+	if err != nil {
+		return nil, err
+	}
+	if len(points) < 3 {
+		return nil, p.errorf(tok, "_geoPolygon needs at least 3 points, got %d", len(points))
+	}
+	return GeoPolygonExpr{Points: points}, nil
+}
+
+// parseNumbers parses count numbers separated by commas.
+func (p *parser) parseNumbers(count int) ([]float64, error) {
+	numbers := make([]float64, count)
+	for i := range numbers {
+		if i > 0 {
+			if _, err := p.expect(tokenComma, ","); err != nil {
+				return nil, err
+			}
+		}
+		tok := p.next()
+		n, err := strconv.ParseFloat(tok.text, 64)
+		if tok.kind == tokenEOF || err != nil {
+			return nil, p.errorf(tok, "unexpected %s, expected a number", tok)
+		}
+		numbers[i] = n
+	}
+	return numbers, nil
+}
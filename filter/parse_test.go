@@ -0,0 +1,199 @@
+package filter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		want   Expr
+	}{
+		{
+			name:   "Condition",
+			filter: `genres = horror`,
+			want:   Condition{Attribute: "genres", Operator: Equal, Value: Value{Raw: "horror"}},
+		},
+		{
+			name:   "Quoted values",
+			filter: `"release date" >= '2024-01-01' AND title != "Carol \"2015\""`,
+			want: AndExpr{Exprs: []Expr{
+				Condition{Attribute: "release date", Operator: GreaterThanOrEqual, Value: Value{Raw: "2024-01-01", Quoted: true}},
+				Condition{Attribute: "title", Operator: NotEqual, Value: Value{Raw: `Carol "2015"`, Quoted: true}},
+			}},
+		},
+		{
+			name:   "Precedence",
+			filter: `a < 1 OR b <= 2 AND NOT c > 3`,
+			want: OrExpr{Exprs: []Expr{
+				Condition{Attribute: "a", Operator: LowerThan, Value: Value{Raw: "1"}},
+				AndExpr{Exprs: []Expr{
+					Condition{Attribute: "b", Operator: LowerThanOrEqual, Value: Value{Raw: "2"}},
+					NotExpr{Expr: Condition{Attribute: "c", Operator: GreaterThan, Value: Value{Raw: "3"}}},
+				}},
+			}},
+		},
+		{
+			name:   "Parentheses",
+			filter: `(a = 1 or b = 2) and c exists`,
+			want: AndExpr{Exprs: []Expr{
+				OrExpr{Exprs: []Expr{
+					Condition{Attribute: "a", Operator: Equal, Value: Value{Raw: "1"}},
+					Condition{Attribute: "b", Operator: Equal, Value: Value{Raw: "2"}},
+				}},
+				Exists{Attribute: "c"},
+			}},
+		},
+		{
+			name:   "Range",
+			filter: `year 1990 TO 2000`,
+			want:   Range{Attribute: "year", From: Value{Raw: "1990"}, To: Value{Raw: "2000"}},
+		},
+		{
+			name:   "In",
+			filter: `genres IN [Drama, "Science Fiction",] AND id NOT IN []`,
+			want: AndExpr{Exprs: []Expr{
+				In{Attribute: "genres", Values: []Value{{Raw: "Drama"}, {Raw: "Science Fiction", Quoted: true}}},
+				In{Attribute: "id", Negated: true},
+			}},
+		},
+		{
+			name:   "Exists, null and empty",
+			filter: `a NOT EXISTS AND b IS NULL AND c IS NOT NULL AND d IS EMPTY AND e IS NOT EMPTY`,
+			want: AndExpr{Exprs: []Expr{
+				Exists{Attribute: "a", Negated: true},
+				IsNull{Attribute: "b"},
+				IsNull{Attribute: "c", Negated: true},
+				IsEmpty{Attribute: "d"},
+				IsEmpty{Attribute: "e", Negated: true},
+			}},
+		},
+		{
+			name:   "Contains and starts with",
+			filter: `title CONTAINS carol AND title not contains "the" AND director STARTS WITH 'Todd' AND director NOT STARTS WITH Ryan`,
+			want: AndExpr{Exprs: []Expr{
+				Contains{Attribute: "title", Value: Value{Raw: "carol"}},
+				Contains{Attribute: "title", Value: Value{Raw: "the", Quoted: true}, Negated: true},
+				StartsWith{Attribute: "director", Value: Value{Raw: "Todd", Quoted: true}},
+				StartsWith{Attribute: "director", Value: Value{Raw: "Ryan"}, Negated: true},
+			}},
+		},
+		{
+			name:   "Geo",
+			filter: `_geoRadius(45.47, 9.18, 2000) OR _geoBoundingBox([45.49, 9.21], [45.44, 9.17]) OR _geoPolygon([45.49, 9.21], [45.44, 9.17], [45.47, 9.18])`,
+			want: OrExpr{Exprs: []Expr{
+				GeoRadiusExpr{Lat: 45.47, Lng: 9.18, Distance: 2000},
+				GeoBoundingBoxExpr{TopLeftLat: 45.49, TopLeftLng: 9.21, BottomRightLat: 45.44, BottomRightLng: 9.17},
+				GeoPolygonExpr{Points: [][2]float64{{45.49, 9.21}, {45.44, 9.17}, {45.47, 9.18}}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := Parse(tt.filter)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, expr)
+
+			// the rendered expression parses back to the same expression
+			again, err := Parse(expr.String())
+			require.NoError(t, err)
+			require.Equal(t, expr.String(), again.String())
+		})
+	}
+
+	expr, err := Parse("  ")
+	require.NoError(t, err)
+	require.Nil(t, expr)
+}
+
+func TestParse_String(t *testing.T) {
+	values := []string{
+		`C:\movies\\`,
+		`\"Carol\"`,
+		`\\"Carol\\"`,
+		`it's \"Carol"`,
+		`\'Carol\' \\`,
+		`Carol" OR id EXISTS OR title = "`,
+		`Carol' OR id EXISTS OR title = '`,
+		"AND",
+		"NOT IN",
+		"_geoRadius(1, 2, 3)",
+	}
+
+	for _, value := range values {
+		t.Run(value, func(t *testing.T) {
+			expr := And(
+				Attr(value).Eq(value),
+				Attr("title").NotIn(value, "Drama"),
+				Attr("year").To(value, 2000),
+				Attr(value).NotContains(value),
+				Attr("title").StartsWith(value),
+				Not(Attr(value).Exists()),
+			)
+			parsed, err := Parse(expr.String())
+			require.NoError(t, err)
+			require.Equal(t, expr, parsed)
+		})
+	}
+}
+
+func TestParse_Unquotable(t *testing.T) {
+	for _, value := range []string{`C:\movies\`, `\`, `\"Carol\' \`} {
+		t.Run(value, func(t *testing.T) {
+			for _, expr := range []Expr{Attr("title").Eq(value), Or(Attr("a").Eq(1), Attr(value).Exists())} {
+				_, err := json.Marshal(expr)
+				var quoteErr *QuoteError
+				require.ErrorAs(t, err, &quoteErr)
+				require.Equal(t, value, quoteErr.Value)
+
+				// the filter is rejected rather than its meaning changed
+				_, err = Parse(expr.String() + ` OR title = "x"`)
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParse_SyntaxError(t *testing.T) {
+	tests := []struct {
+		filter string
+		pos    int
+		msg    string
+	}{
+		{filter: `genres = `, pos: 9, msg: "unexpected end of filter, expected a value"},
+		{filter: `genres horror`, pos: 13, msg: `unexpected end of filter, expected TO`},
+		{filter: `a = 1 AND`, pos: 9, msg: "unexpected end of filter, expected an attribute, NOT or ("},
+		{filter: `a = 1 b = 2`, pos: 6, msg: `unexpected "b", expected AND, OR or the end of the filter`},
+		{filter: `(a = 1`, pos: 6, msg: `unexpected end of filter, expected ")"`},
+		{filter: `title = "Carol`, pos: 8, msg: "unterminated string"},
+		{filter: `title = "Carol\"`, pos: 8, msg: "unterminated string"},
+		{filter: `a ! 1`, pos: 2, msg: `unexpected "!", expected "!="`},
+		{filter: `a IN [1 2]`, pos: 8, msg: `unexpected "2", expected "]"`},
+		{filter: `a IS 1`, pos: 5, msg: `unexpected "1", expected NULL or EMPTY`},
+		{filter: `a NOT NULL`, pos: 6, msg: `unexpected "NULL", expected IN, EXISTS, CONTAINS or STARTS WITH`},
+		{filter: `title STARTS carol`, pos: 13, msg: `unexpected "carol", expected WITH`},
+		{filter: `title CONTAINS`, pos: 14, msg: "unexpected end of filter, expected a value"},
+		{filter: `AND = 1`, pos: 0, msg: `unexpected "AND", expected an attribute, NOT or (`},
+		{filter: `_geo = 1`, pos: 0, msg: "_geo is reserved, filter with _geoRadius, _geoBoundingBox or _geoPolygon instead"},
+		{filter: `_geoRadius(1, north, 3)`, pos: 14, msg: `unexpected "north", expected a number`},
+		{filter: `_geoBoundingBox([1, 2] [3, 4])`, pos: 23, msg: `unexpected "[", expected ","`},
+		{filter: `_geoPolygon([1, 2], [3, 4])`, pos: 26, msg: "_geoPolygon needs at least 3 points, got 2"},
+		{filter: `_geoPolygon([1, 2], [3, 4] [5, 6])`, pos: 27, msg: `unexpected "[", expected ")"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			_, err := Parse(tt.filter)
+			var syntaxErr *SyntaxError
+			require.ErrorAs(t, err, &syntaxErr)
+			require.Equal(t, tt.filter, syntaxErr.Filter)
+			require.Equal(t, tt.pos, syntaxErr.Pos)
+			require.Equal(t, tt.msg, syntaxErr.Msg)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package filter
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// geoAttribute is the attribute filtered by GeoRadiusExpr and GeoBoundingBoxExpr.
+const geoAttribute = "_geo"
+
+// FilterableAttributesGetter fetches the filterable attributes of an index, it is implemented by
+// meilisearch.IndexManager.
+type FilterableAttributesGetter interface {
+	GetFilterableAttributesWithContext(ctx context.Context) (*[]string, error)
+}
+
+// NotFilterableError is returned by Validate when a filter uses attributes that are not
+// filterable, Meilisearch would reject it with the code invalid_search_filter.
+type NotFilterableError struct {
+	// Attributes are the attributes of the filter that are not filterable.
+	Attributes []string
+
+	// Filterable are the filterable attributes of the index.
+	Filterable []string
+}
+
+func (e *NotFilterableError) Error() string {
+	return "filter: attributes " + strings.Join(e.Attributes, ", ") + " are not filterable, the filterable attributes are " +
+		strings.Join(e.Filterable, ", ")
+}
+
+// Validate checks that all the attributes of expr are filterable attributes of index, a
+// *NotFilterableError is returned otherwise.
+func Validate(ctx context.Context, expr Expr, index FilterableAttributesGetter) error {
+	filterable, err := index.GetFilterableAttributesWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	if filterable == nil {
+		return ValidateAttributes(expr, nil)
+	}
+	return ValidateAttributes(expr, *filterable)
+}
+
+// ValidateAttributes checks that all the attributes of expr are in filterable, a
+// *NotFilterableError is returned otherwise. The filterable attributes can be patterns with a *
+// at their start or end, genre* matching genres and *_id matching author_id, and * makes all the
+// attributes filterable. A nested attribute is filterable when one of its parents is.
+func ValidateAttributes(expr Expr, filterable []string) error {
+	var invalid []string
+	for _, attribute := range Attributes(expr) {
+		if !isFilterable(attribute, filterable) {
+			invalid = append(invalid, attribute)
+		}
+	}
+	if len(invalid) != 0 {
+		return &NotFilterableError{Attributes: invalid, Filterable: filterable}
+	}
+	return nil
+}
+
+// Attributes returns the attributes used by expr, sorted and without duplicates. The geo
+// expressions use the attribute _geo.
+func Attributes(expr Expr) []string {
+	seen := make(map[string]bool)
+	collectAttributes(expr, seen)
+
+	attributes := make([]string, 0, len(seen))
+	for attribute := range seen {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+	return attributes
+}
+
+func collectAttributes(expr Expr, seen map[string]bool) {
+	switch e := expr.(type) {
+	case Condition:
+		seen[e.Attribute] = true
+	case Range:
+		seen[e.Attribute] = true
+	case In:
+		seen[e.Attribute] = true
+	case Exists:
+		seen[e.Attribute] = true
+	case IsNull:
+		seen[e.Attribute] = true
+	case IsEmpty:
+		seen[e.Attribute] = true
+	case Contains:
+		seen[e.Attribute] = true
+	case StartsWith:
+		seen[e.Attribute] = true
+	case GeoRadiusExpr, GeoBoundingBoxExpr, GeoPolygonExpr:
+		seen[geoAttribute] = true
+	case AndExpr:
+		for _, expr := range e.Exprs {
+			collectAttributes(expr, seen)
+		}
+	case OrExpr:
+		for _, expr := range e.Exprs {
+			collectAttributes(expr, seen)
+		}
+	case NotExpr:
+		collectAttributes(e.Expr, seen)
+	}
+}
+
+func isFilterable(attribute string, filterable []string) bool {
+	for _, pattern := range filterable {
+		// the attribute and its parents, release.year then release
+		for name := attribute; ; {
+			if matchPattern(pattern, name) {
+				return true
+			}
+			i := strings.LastIndexByte(name, '.')
+			if i < 0 {
+				break
+			}
+			name = name[:i]
+		}
+	}
+	return false
+}
+
+// matchPattern reports whether name matches the attribute pattern, a * at its start or end
+// matching any characters.
+func matchPattern(pattern, name string) bool {
+	prefix := strings.HasSuffix(pattern, "*")
+	suffix := strings.HasPrefix(pattern, "*")
+	switch {
+	case pattern == "*":
+		return true
+	case prefix && suffix:
+		return strings.Contains(name, pattern[1:len(pattern)-1])
+	case prefix:
+		return strings.HasPrefix(name, pattern[:len(pattern)-1])
+	case suffix:
+		return strings.HasSuffix(name, pattern[1:])
+	}
+	return pattern == name
+}
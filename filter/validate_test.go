@@ -0,0 +1,80 @@
+package filter_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/meilisearch/meilisearch-go/filter"
+	"github.com/stretchr/testify/require"
+)
+
+var _ filter.FilterableAttributesGetter = meilisearch.IndexManager(nil)
+
+func TestValidateAttributes(t *testing.T) {
+	expr, err := filter.Parse(`genres = Drama AND (release.year > 2000 OR NOT director EXISTS) AND _geoRadius(1, 2, 3)`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"_geo", "director", "genres", "release.year"}, filter.Attributes(expr))
+
+	require.NoError(t, filter.ValidateAttributes(expr, []string{"genres", "release", "director", "_geo"}))
+	require.NoError(t, filter.ValidateAttributes(expr, []string{"*"}))
+
+	err = filter.ValidateAttributes(expr, []string{"genres", "release.month", "releases"})
+	var notFilterable *filter.NotFilterableError
+	require.ErrorAs(t, err, &notFilterable)
+	require.Equal(t, []string{"_geo", "director", "release.year"}, notFilterable.Attributes)
+	require.EqualError(t, err, "filter: attributes _geo, director, release.year are not filterable, "+
+		"the filterable attributes are genres, release.month, releases")
+
+	require.NoError(t, filter.ValidateAttributes(nil, nil))
+}
+
+func TestValidateAttributes_Patterns(t *testing.T) {
+	tests := []struct {
+		attribute  string
+		filterable []string
+		want       bool
+	}{
+		{attribute: "genres", filterable: []string{"genre*"}, want: true},
+		{attribute: "genre", filterable: []string{"genre*"}, want: true},
+		{attribute: "subgenres", filterable: []string{"genre*"}, want: false},
+		{attribute: "author_id", filterable: []string{"*_id"}, want: true},
+		{attribute: "author.id", filterable: []string{"*_id"}, want: false},
+		{attribute: "author_id.value", filterable: []string{"*_id"}, want: true},
+		{attribute: "release_date", filterable: []string{"*date*"}, want: true},
+		{attribute: "release.date", filterable: []string{"release*"}, want: true},
+		{attribute: "release.year", filterable: []string{"*.year"}, want: true},
+		{attribute: "title", filterable: []string{"genre*", "*_id"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute+" "+tt.filterable[0], func(t *testing.T) {
+			err := filter.ValidateAttributes(filter.Attr(tt.attribute).Exists(), tt.filterable)
+			if tt.want {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/indexes/movies/settings/filterable-attributes", r.URL.Path)
+		_, _ = w.Write([]byte(`["genres", "*_id"]`))
+	}))
+	defer ts.Close()
+
+	idx := meilisearch.New(ts.URL).Index("movies")
+
+	require.NoError(t, filter.Validate(context.Background(), filter.And(filter.Attr("genres").In("Drama"), filter.Attr("author_id").Eq(1)), idx))
+
+	err := filter.Validate(context.Background(), filter.Attr("title").Eq("Carol"), idx)
+	var notFilterable *filter.NotFilterableError
+	require.ErrorAs(t, err, &notFilterable)
+	require.Equal(t, []string{"title"}, notFilterable.Attributes)
+	require.Equal(t, []string{"genres", "*_id"}, notFilterable.Filterable)
+}
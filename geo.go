@@ -0,0 +1,73 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/meilisearch/meilisearch-go/filter"
+)
+
+// GeoPoint is the location of a document in its _geo field, used by the geo filters and the geo
+// sort. The distance of the hits to the point of a geo sort is in SearchHitMetadata.GeoDistance.
+//
+//	type Store struct {
+//		ID  int                   `json:"id"`
+//		Geo *meilisearch.GeoPoint `json:"_geo,omitempty"`
+//	}
+//
+// Documentation: https://www.meilisearch.com/docs/learn/filtering_and_sorting/geosearch
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// UnmarshalJSON decodes the coordinates given either as numbers or as strings, Meilisearch
+// accepts both.
+func (p *GeoPoint) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		Lat json.Number `json:"lat"`
+		Lng json.Number `json:"lng"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	lat, err := aux.Lat.Float64()
+	if err != nil {
+		return err
+	}
+	lng, err := aux.Lng.Float64()
+	if err != nil {
+		return err
+	}
+	p.Lat, p.Lng = lat, lng
+	return nil
+}
+
+// SortAsc returns the rule of SearchRequest.Sort ordering the hits from the nearest to the
+// farthest of the point.
+func (p GeoPoint) SortAsc() string {
+	return p.sort() + ":asc"
+}
+
+// SortDesc returns the rule of SearchRequest.Sort ordering the hits from the farthest to the
+// nearest of the point.
+func (p GeoPoint) SortDesc() string {
+	return p.sort() + ":desc"
+}
+
+func (p GeoPoint) sort() string {
+	return "_geoPoint(" + strconv.FormatFloat(p.Lat, 'f', -1, 64) + ", " + strconv.FormatFloat(p.Lng, 'f', -1, 64) + ")"
+}
+
+// WithinRadius returns the filter matching the documents whose _geo is within meters of the
+// point.
+func (p GeoPoint) WithinRadius(meters float64) filter.GeoRadiusExpr {
+	return filter.GeoRadius(p.Lat, p.Lng, meters)
+}
+
+// WithinBoundingBox returns the filter matching the documents whose _geo is within the rectangle
+// whose top left corner is topLeft and bottom right corner is bottomRight.
+func WithinBoundingBox(topLeft, bottomRight GeoPoint) filter.GeoBoundingBoxExpr {
+	return filter.GeoBoundingBox(topLeft.Lat, topLeft.Lng, bottomRight.Lat, bottomRight.Lng)
+}
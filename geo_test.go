@@ -0,0 +1,58 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoPoint_UnmarshalJSON(t *testing.T) {
+	var point GeoPoint
+	require.NoError(t, json.Unmarshal([]byte(`{"lat": 45.4628328, "lng": 9.1076931}`), &point))
+	require.Equal(t, GeoPoint{Lat: 45.4628328, Lng: 9.1076931}, point)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"lat": "-12.5", "lng": "3"}`), &point))
+	require.Equal(t, GeoPoint{Lat: -12.5, Lng: 3}, point)
+
+	require.Error(t, json.Unmarshal([]byte(`{"lat": "north", "lng": 3}`), &point))
+}
+
+func TestGeoPoint_Search(t *testing.T) {
+	type store struct {
+		ID  int       `json:"id"`
+		Geo *GeoPoint `json:"_geo"`
+	}
+
+	var request map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &request))
+		_, _ = w.Write([]byte(`{"hits":[{"id":1,"_geo":{"lat":45.4777599,"lng":9.1967508},"_geoDistance":1532}],` +
+			`"query":"","processingTimeMs":1}`))
+	}))
+	defer ts.Close()
+
+	milan := GeoPoint{Lat: 45.472735, Lng: 9.184019}
+	resp, err := SearchAs[store](context.Background(), New(ts.URL).Index("stores"), "", &SearchRequest{
+		Filter: filter.Or(
+			milan.WithinRadius(2000),
+			WithinBoundingBox(GeoPoint{Lat: 45.494181, Lng: 9.214024}, GeoPoint{Lat: 45.449484, Lng: 9.179175}),
+		),
+		Sort: []string{milan.SortAsc(), GeoPoint{Lat: 1.5, Lng: -2}.SortDesc()},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "_geoRadius(45.472735, 9.184019, 2000) OR "+
+		"_geoBoundingBox([45.494181, 9.214024], [45.449484, 9.179175])", request["filter"])
+	require.Equal(t, []interface{}{"_geoPoint(45.472735, 9.184019):asc", "_geoPoint(1.5, -2):desc"}, request["sort"])
+
+	require.Equal(t, &GeoPoint{Lat: 45.4777599, Lng: 9.1967508}, resp.Hits[0].Geo)
+	require.Equal(t, float64(1532), resp.HitsMetadata[0].GeoDistance)
+}
@@ -1,9 +1,17 @@
 module github.com/meilisearch/meilisearch-go
 
-go 1.16
+go 1.18
 
 require (
+	github.com/andybalholm/brotli v1.1.0
 	github.com/golang-jwt/jwt/v4 v4.5.0
 	github.com/mailru/easyjson v0.7.7
 	github.com/stretchr/testify v1.8.2
 )
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/josharian/intern v1.0.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
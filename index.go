@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
-	"strconv"
 	"strings"
 	"time"
 )
@@ -156,6 +155,12 @@ type IndexManager interface {
 	// GetDocumentsWithContext retrieves multiple documents from the index using the provided context for cancellation.
 	GetDocumentsWithContext(ctx context.Context, param *DocumentsQuery, resp *DocumentsResult) error
 
+	// GetDocumentsStream retrieves multiple documents from the index, returning the response body as it is received, which the caller must close.
+	GetDocumentsStream(param *DocumentsQuery) (io.ReadCloser, error)
+
+	// GetDocumentsStreamWithContext retrieves multiple documents from the index using the provided context for cancellation, returning the response body as it is received, which the caller must close.
+	GetDocumentsStreamWithContext(ctx context.Context, param *DocumentsQuery) (io.ReadCloser, error)
+
 	// DeleteDocument deletes a single document from the index by identifier.
 	DeleteDocument(identifier string) (*TaskInfo, error)
 
@@ -192,6 +197,18 @@ type IndexManager interface {
 	// SearchRawWithContext performs a raw search query on the index using the provided context for cancellation, returning a JSON response.
 	SearchRawWithContext(ctx context.Context, query string, request *SearchRequest) (*json.RawMessage, error)
 
+	// SearchStream performs a search query on the index, returning the response body as it is received, which the caller must close.
+	SearchStream(query string, request *SearchRequest) (io.ReadCloser, error)
+
+	// SearchStreamWithContext performs a search query on the index using the provided context for cancellation, returning the response body as it is received, which the caller must close.
+	SearchStreamWithContext(ctx context.Context, query string, request *SearchRequest) (io.ReadCloser, error)
+
+	// SearchIterator walks all the hits of a search query on the index, page after page.
+	SearchIterator(query string, request *SearchRequest, opts *SearchIteratorOptions) *SearchIterator
+
+	// SearchIteratorWithContext walks all the hits of a search query on the index, page after page, using the provided context for cancellation.
+	SearchIteratorWithContext(ctx context.Context, query string, request *SearchRequest, opts *SearchIteratorOptions) *SearchIterator
+
 	// FacetSearch performs a facet search query on the index.
 	FacetSearch(request *FacetSearchRequest) (*json.RawMessage, error)
 
@@ -216,6 +233,12 @@ type IndexManager interface {
 	// GetTasksWithContext retrieves multiple tasks based on query parameters using the provided context for cancellation.
 	GetTasksWithContext(ctx context.Context, param *TasksQuery) (*TaskResult, error)
 
+	// TasksIterator walks all the tasks of the index matching the query, page after page.
+	TasksIterator(param *TasksQuery) *TasksIterator
+
+	// TasksIteratorWithContext walks all the tasks of the index matching the query, page after page, using the provided context for cancellation.
+	TasksIteratorWithContext(ctx context.Context, param *TasksQuery) *TasksIterator
+
 	// GetSettings retrieves the settings of the index.
 	GetSettings() (*Settings, error)
 
@@ -585,6 +608,18 @@ type IndexManager interface {
 
 	// WaitForTaskWithContext waits for a task to complete by its UID with the given interval using the provided context for cancellation.
 	WaitForTaskWithContext(ctx context.Context, taskUID int64, interval time.Duration) (*Task, error)
+
+	// WaitForTaskSuccess waits for a task to complete by its UID with the given interval, a *TaskFailedError is returned when it failed or was canceled.
+	WaitForTaskSuccess(taskUID int64, interval time.Duration) (*Task, error)
+
+	// WaitForTaskSuccessWithContext waits for a task to complete by its UID with the given interval using the provided context for cancellation, a *TaskFailedError is returned when it failed or was canceled.
+	WaitForTaskSuccessWithContext(ctx context.Context, taskUID int64, interval time.Duration) (*Task, error)
+
+	// WaitForTasks waits for several tasks to complete, such as the ones returned by the batch methods, polling them with a single tasks query, an unknown task fails with ErrTaskNotFound.
+	WaitForTasks(taskUIDs []int64, opts *WaitForTasksOptions) ([]Task, error)
+
+	// WaitForTasksWithContext waits for several tasks to complete, polling them with a single tasks query, using the provided context for cancellation.
+	WaitForTasksWithContext(ctx context.Context, taskUIDs []int64, opts *WaitForTasksOptions) ([]Task, error)
 }
 
 func newIndex(cli *client, uid string) IndexManager {
@@ -724,27 +759,7 @@ func (i *index) GetTasksWithContext(ctx context.Context, param *TasksQuery) (*Ta
 		functionName:        "GetTasks",
 	}
 	if param != nil {
-		if param.Limit != 0 {
-			req.withQueryParams["limit"] = strconv.FormatInt(param.Limit, 10)
-		}
-		if param.From != 0 {
-			req.withQueryParams["from"] = strconv.FormatInt(param.From, 10)
-		}
-		if len(param.Statuses) != 0 {
-			statuses := make([]string, len(param.Statuses))
-			for i, status := range param.Statuses {
-				statuses[i] = string(status)
-			}
-			req.withQueryParams["statuses"] = strings.Join(statuses, ",")
-		}
-
-		if len(param.Types) != 0 {
-			types := make([]string, len(param.Types))
-			for i, t := range param.Types {
-				types[i] = string(t)
-			}
-			req.withQueryParams["types"] = strings.Join(types, ",")
-		}
+		encodeTasksQuery(param, req)
 		if len(param.IndexUIDS) != 0 {
 			param.IndexUIDS = append(param.IndexUIDS, i.uid)
 			req.withQueryParams["indexUids"] = strings.Join(param.IndexUIDS, ",")
@@ -758,6 +773,18 @@ func (i *index) GetTasksWithContext(ctx context.Context, param *TasksQuery) (*Ta
 	return resp, nil
 }
 
+func (i *index) TasksIterator(param *TasksQuery) *TasksIterator {
+	return i.TasksIteratorWithContext(context.Background(), param)
+}
+
+func (i *index) TasksIteratorWithContext(ctx context.Context, param *TasksQuery) *TasksIterator {
+	if param == nil {
+		// the tasks are only restricted to the index with a query
+		param = &TasksQuery{}
+	}
+	return newTasksIterator(ctx, i.GetTasksWithContext, param)
+}
+
 func (i *index) WaitForTask(taskUID int64, interval time.Duration) (*Task, error) {
 	return waitForTask(context.Background(), i.client, taskUID, interval)
 }
@@ -765,3 +792,19 @@ func (i *index) WaitForTask(taskUID int64, interval time.Duration) (*Task, error
 func (i *index) WaitForTaskWithContext(ctx context.Context, taskUID int64, interval time.Duration) (*Task, error) {
 	return waitForTask(ctx, i.client, taskUID, interval)
 }
+
+func (i *index) WaitForTaskSuccess(taskUID int64, interval time.Duration) (*Task, error) {
+	return waitForTaskSuccess(context.Background(), i.client, taskUID, interval)
+}
+
+func (i *index) WaitForTaskSuccessWithContext(ctx context.Context, taskUID int64, interval time.Duration) (*Task, error) {
+	return waitForTaskSuccess(ctx, i.client, taskUID, interval)
+}
+
+func (i *index) WaitForTasks(taskUIDs []int64, opts *WaitForTasksOptions) ([]Task, error) {
+	return waitForTasks(context.Background(), i.client, taskUIDs, opts)
+}
+
+func (i *index) WaitForTasksWithContext(ctx context.Context, taskUIDs []int64, opts *WaitForTasksOptions) ([]Task, error) {
+	return waitForTasks(ctx, i.client, taskUIDs, opts)
+}
@@ -235,6 +235,7 @@ func (i *index) GetDocumentWithContext(ctx context.Context, identifier string, r
 		withResponse:        documentPtr,
 		withQueryParams:     map[string]string{},
 		acceptedStatusCodes: []int{http.StatusOK},
+		readOnly:            true,
 		functionName:        "GetDocument",
 	}
 	if request != nil {
@@ -253,6 +254,31 @@ func (i *index) GetDocuments(param *DocumentsQuery, resp *DocumentsResult) error
 }
 
 func (i *index) GetDocumentsWithContext(ctx context.Context, param *DocumentsQuery, resp *DocumentsResult) error {
+	req := i.documentsRequest(param, resp)
+	req.functionName = "GetDocuments"
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return VersionErrorHintMessage(err, req)
+	}
+	return nil
+}
+
+func (i *index) GetDocumentsStream(param *DocumentsQuery) (io.ReadCloser, error) {
+	return i.GetDocumentsStreamWithContext(context.Background(), param)
+}
+
+func (i *index) GetDocumentsStreamWithContext(ctx context.Context, param *DocumentsQuery) (io.ReadCloser, error) {
+	var body io.ReadCloser
+	req := i.documentsRequest(param, &body)
+	req.functionName = "GetDocumentsStream"
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, VersionErrorHintMessage(err, req)
+	}
+	return body, nil
+}
+
+// documentsRequest returns the request fetching the documents matching param, a filter is sent
+// in the body of a POST request.
+func (i *index) documentsRequest(param *DocumentsQuery, resp interface{}) *internalRequest {
 	req := &internalRequest{
 		endpoint:            "/indexes/" + i.uid + "/documents",
 		method:              http.MethodGet,
@@ -261,7 +287,7 @@ func (i *index) GetDocumentsWithContext(ctx context.Context, param *DocumentsQue
 		withResponse:        resp,
 		withQueryParams:     nil,
 		acceptedStatusCodes: []int{http.StatusOK},
-		functionName:        "GetDocuments",
+		readOnly:            true,
 	}
 	if param != nil && param.Filter == nil {
 		req.withQueryParams = map[string]string{}
@@ -279,10 +305,7 @@ func (i *index) GetDocumentsWithContext(ctx context.Context, param *DocumentsQue
 		req.method = http.MethodPost
 		req.endpoint = req.endpoint + "/fetch"
 	}
-	if err := i.client.executeRequest(ctx, req); err != nil {
-		return VersionErrorHintMessage(err, req)
-	}
-	return nil
+	return req
 }
 
 func (i *index) DeleteDocument(identifier string) (*TaskInfo, error) {
@@ -383,13 +406,14 @@ func (i *index) addDocuments(ctx context.Context, documentsPtr interface{}, cont
 		endpoint = "/indexes/" + i.uid + "/documents?" + generateQueryForOptions(options)
 	}
 	req := &internalRequest{
-		endpoint:            endpoint,
-		method:              http.MethodPost,
-		contentType:         contentType,
-		withRequest:         documentsPtr,
-		withResponse:        resp,
-		acceptedStatusCodes: []int{http.StatusAccepted},
-		functionName:        "AddDocuments",
+		endpoint:                endpoint,
+		method:                  http.MethodPost,
+		contentType:             contentType,
+		withRequest:             documentsPtr,
+		withResponse:            resp,
+		acceptedStatusCodes:     []int{http.StatusAccepted},
+		requireIdempotencyGuard: true,
+		functionName:            "AddDocuments",
 	}
 	if err = i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
@@ -507,13 +531,14 @@ func (i *index) updateDocuments(ctx context.Context, documentsPtr interface{}, c
 		endpoint = "/indexes/" + i.uid + "/documents?" + generateQueryForOptions(options)
 	}
 	req := &internalRequest{
-		endpoint:            endpoint,
-		method:              http.MethodPut,
-		contentType:         contentType,
-		withRequest:         documentsPtr,
-		withResponse:        resp,
-		acceptedStatusCodes: []int{http.StatusAccepted},
-		functionName:        "UpdateDocuments",
+		endpoint:                endpoint,
+		method:                  http.MethodPut,
+		contentType:             contentType,
+		withRequest:             documentsPtr,
+		withResponse:            resp,
+		acceptedStatusCodes:     []int{http.StatusAccepted},
+		requireIdempotencyGuard: true,
+		functionName:            "UpdateDocuments",
 	}
 	if err = i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
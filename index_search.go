@@ -3,6 +3,7 @@ package meilisearch
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 )
 
@@ -11,36 +12,10 @@ func (i *index) Search(query string, request *SearchRequest) (*SearchResponse, e
 }
 
 func (i *index) SearchWithContext(ctx context.Context, query string, request *SearchRequest) (*SearchResponse, error) {
-	if request == nil {
-		return nil, ErrNoSearchRequest
-	}
-
-	if query != "" {
-		request.Query = query
-	}
-
-	if request.IndexUID != "" {
-		request.IndexUID = ""
-	}
-
-	request.validate()
-
 	resp := new(SearchResponse)
-
-	req := &internalRequest{
-		endpoint:            "/indexes/" + i.uid + "/search",
-		method:              http.MethodPost,
-		contentType:         contentTypeJSON,
-		withRequest:         request,
-		withResponse:        resp,
-		acceptedStatusCodes: []int{http.StatusOK},
-		functionName:        "Search",
-	}
-
-	if err := i.client.executeRequest(ctx, req); err != nil {
+	if err := i.search(ctx, "Search", query, request, resp); err != nil {
 		return nil, err
 	}
-
 	return resp, nil
 }
 
@@ -49,8 +24,29 @@ func (i *index) SearchRaw(query string, request *SearchRequest) (*json.RawMessag
 }
 
 func (i *index) SearchRawWithContext(ctx context.Context, query string, request *SearchRequest) (*json.RawMessage, error) {
+	resp := new(json.RawMessage)
+	if err := i.search(ctx, "SearchRaw", query, request, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i *index) SearchStream(query string, request *SearchRequest) (io.ReadCloser, error) {
+	return i.SearchStreamWithContext(context.Background(), query, request)
+}
+
+func (i *index) SearchStreamWithContext(ctx context.Context, query string, request *SearchRequest) (io.ReadCloser, error) {
+	var body io.ReadCloser
+	if err := i.search(ctx, "SearchStream", query, request, &body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// search sends request to the search route of the index and decodes the response into resp.
+func (i *index) search(ctx context.Context, functionName string, query string, request *SearchRequest, resp interface{}) error {
 	if request == nil {
-		return nil, ErrNoSearchRequest
+		return ErrNoSearchRequest
 	}
 
 	if query != "" {
@@ -63,8 +59,6 @@ func (i *index) SearchRawWithContext(ctx context.Context, query string, request
 
 	request.validate()
 
-	resp := new(json.RawMessage)
-
 	req := &internalRequest{
 		endpoint:            "/indexes/" + i.uid + "/search",
 		method:              http.MethodPost,
@@ -72,14 +66,19 @@ func (i *index) SearchRawWithContext(ctx context.Context, query string, request
 		withRequest:         request,
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusOK},
-		functionName:        "SearchRaw",
+		readOnly:            true,
+		functionName:        functionName,
 	}
 
-	if err := i.client.executeRequest(ctx, req); err != nil {
-		return nil, err
-	}
+	return i.client.executeRequest(ctx, req)
+}
 
-	return resp, nil
+func (i *index) SearchIterator(query string, request *SearchRequest, opts *SearchIteratorOptions) *SearchIterator {
+	return i.SearchIteratorWithContext(context.Background(), query, request, opts)
+}
+
+func (i *index) SearchIteratorWithContext(ctx context.Context, query string, request *SearchRequest, opts *SearchIteratorOptions) *SearchIterator {
+	return newSearchIterator(ctx, i, query, request, opts)
 }
 
 func (i *index) FacetSearch(request *FacetSearchRequest) (*json.RawMessage, error) {
@@ -100,6 +99,7 @@ func (i *index) FacetSearchWithContext(ctx context.Context, request *FacetSearch
 		withRequest:         request,
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusOK},
+		readOnly:            true,
 		functionName:        "FacetSearch",
 	}
 
@@ -121,6 +121,7 @@ func (i *index) SearchSimilarDocumentsWithContext(ctx context.Context, param *Si
 		withRequest:         param,
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusOK},
+		readOnly:            true,
 		functionName:        "SearchSimilarDocuments",
 		contentType:         contentTypeJSON,
 	}
@@ -0,0 +1,86 @@
+//go:build go1.21
+// +build go1.21
+
+package meilisearch
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// WithLogger logs every request sent by the client with logger: at debug level once the request
+// is done, at warn level with the fields of the returned *Error when it failed. The headers are
+// the ones of the request as sent, they are logged once a response was received. The API keys
+// are redacted from the logged headers, endpoints and bodies.
+func WithLogger(logger *slog.Logger) Option {
+	return WithMiddleware(loggingMiddleware(logger))
+}
+
+func loggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			start := time.Now()
+			resp, err := next(ctx, req)
+
+			level := slog.LevelDebug
+			if err != nil {
+				level = slog.LevelWarn
+			}
+			if !logger.Enabled(ctx, level) {
+				return resp, err
+			}
+
+			attrs := []slog.Attr{
+				slog.String("function", req.Function),
+				slog.String("method", req.Method),
+				slog.String("endpoint", redactEndpoint(req.Endpoint)),
+				slog.Duration("duration", time.Since(start)),
+			}
+			if resp != nil {
+				attrs = append(attrs, slog.Int("status", resp.StatusCode))
+				if len(resp.RequestHeader) != 0 {
+					attrs = append(attrs, slog.Any("headers", redactHeader(resp.RequestHeader)))
+				}
+			}
+
+			if err == nil {
+				logger.LogAttrs(ctx, level, "meilisearch request", attrs...)
+				return resp, err
+			}
+
+			attrs = append(attrs, errorAttr(err))
+			logger.LogAttrs(ctx, level, "meilisearch request failed", attrs...)
+			return resp, err
+		}
+	}
+}
+
+// errorAttr describes err with the fields of *Error rather than with its message, which embeds
+// the whole request and response bodies.
+func errorAttr(err error) slog.Attr {
+	var meiliErr *Error
+	if !errors.As(err, &meiliErr) {
+		return slog.String("error", err.Error())
+	}
+
+	attrs := []slog.Attr{
+		slog.Int("err_code", int(meiliErr.ErrCode)),
+		slog.Int("status_code", meiliErr.StatusCode),
+		slog.Any("status_code_expected", meiliErr.StatusCodeExpected),
+		slog.String("response", redactSecrets(meiliErr.ResponseToString)),
+	}
+	if meiliErr.MeilisearchApiError.Code != "" {
+		attrs = append(attrs,
+			slog.String("message", meiliErr.MeilisearchApiError.Message),
+			slog.String("code", string(meiliErr.MeilisearchApiError.Code)),
+			slog.String("type", meiliErr.MeilisearchApiError.Type),
+			slog.String("link", meiliErr.MeilisearchApiError.Link),
+		)
+	}
+	if meiliErr.OriginError != nil {
+		attrs = append(attrs, slog.String("origin", meiliErr.OriginError.Error()))
+	}
+	return slog.Attr{Key: "error", Value: slog.GroupValue(attrs...)}
+}
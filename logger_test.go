@@ -0,0 +1,93 @@
+//go:build go1.21
+// +build go1.21
+
+package meilisearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLogger(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/health":
+			_, _ = w.Write([]byte(`{"status":"available"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"API key ` + "`secret-key`" + ` not found.","code":"api_key_not_found","key":"secret-key"}`))
+		}
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	meili := New(ts.URL, WithAPIKey("master-key"), WithLogger(logger),
+		WithMiddleware(func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				req.Header.Set("X-Meili-API-Key", "master-key")
+				return next(ctx, req)
+			}
+		}))
+
+	_, err := meili.Health()
+	require.NoError(t, err)
+	_, err = meili.GetKey("secret-key")
+	require.Error(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var health map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &health))
+	require.Equal(t, "DEBUG", health["level"])
+	require.Equal(t, "Health", health["function"])
+	require.Equal(t, http.MethodGet, health["method"])
+	require.Equal(t, "/health", health["endpoint"])
+	require.Equal(t, float64(http.StatusOK), health["status"])
+	require.Contains(t, health, "duration")
+
+	var getKey map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &getKey))
+	require.Equal(t, "WARN", getKey["level"])
+	require.Equal(t, "GetKey", getKey["function"])
+	require.Equal(t, "/keys/[REDACTED]", getKey["endpoint"])
+	require.Equal(t, float64(http.StatusNotFound), getKey["status"])
+	headers, ok := getKey["headers"].(map[string]interface{})
+	require.True(t, ok)
+	require.Equal(t, []interface{}{"[REDACTED]"}, headers["Authorization"])
+	require.Equal(t, []interface{}{"[REDACTED]"}, headers["X-Meili-Api-Key"])
+	require.Equal(t, []interface{}{GetQualifiedVersion()}, headers["User-Agent"])
+	require.Equal(t, headers, health["headers"])
+
+	errAttrs, ok := getKey["error"].(map[string]interface{})
+	require.True(t, ok)
+	require.Equal(t, float64(MeilisearchApiError), errAttrs["err_code"])
+	require.Equal(t, "api_key_not_found", errAttrs["code"])
+	require.Contains(t, errAttrs["response"], `"key":"[REDACTED]"`)
+
+	require.NotContains(t, buf.String(), "master-key")
+	require.NotContains(t, lines[1], `"secret-key"`)
+}
+
+func TestWithLogger_DebugDisabled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"available"}`))
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	meili := New(ts.URL, WithLogger(slog.New(slog.NewTextHandler(&buf, nil))))
+
+	_, err := meili.Health()
+	require.NoError(t, err)
+	require.Empty(t, buf.String())
+}
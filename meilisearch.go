@@ -101,6 +101,12 @@ type ServiceManager interface {
 	// GetTasksWithContext lists all tasks with a context for cancellation.
 	GetTasksWithContext(ctx context.Context, param *TasksQuery) (*TaskResult, error)
 
+	// TasksIterator walks all the tasks matching the query, page after page.
+	TasksIterator(param *TasksQuery) *TasksIterator
+
+	// TasksIteratorWithContext walks all the tasks matching the query, page after page, with a context for cancellation.
+	TasksIteratorWithContext(ctx context.Context, param *TasksQuery) *TasksIterator
+
 	// CancelTasks cancels specific tasks.
 	CancelTasks(param *CancelTasksQuery) (*TaskInfo, error)
 
@@ -113,12 +119,42 @@ type ServiceManager interface {
 	// DeleteTasksWithContext deletes specific tasks with a context for cancellation.
 	DeleteTasksWithContext(ctx context.Context, param *DeleteTasksQuery) (*TaskInfo, error)
 
+	// GetBatch fetches the details of a specific batch of tasks.
+	GetBatch(batchUID int64) (*Batch, error)
+
+	// GetBatchWithContext fetches the details of a specific batch of tasks with a context for cancellation.
+	GetBatchWithContext(ctx context.Context, batchUID int64) (*Batch, error)
+
+	// GetBatches lists all batches of tasks.
+	GetBatches(param *BatchesQuery) (*BatchResult, error)
+
+	// GetBatchesWithContext lists all batches of tasks with a context for cancellation.
+	GetBatchesWithContext(ctx context.Context, param *BatchesQuery) (*BatchResult, error)
+
 	// WaitForTask waits for a specific task to complete.
 	WaitForTask(taskUID int64, interval time.Duration) (*Task, error)
 
 	// WaitForTaskWithContext waits for a specific task to complete with a context for cancellation.
 	WaitForTaskWithContext(ctx context.Context, taskUID int64, interval time.Duration) (*Task, error)
 
+	// WaitForTaskSuccess waits for a specific task to complete, a *TaskFailedError is returned when it failed or was canceled.
+	WaitForTaskSuccess(taskUID int64, interval time.Duration) (*Task, error)
+
+	// WaitForTaskSuccessWithContext waits for a specific task to complete with a context for cancellation, a *TaskFailedError is returned when it failed or was canceled.
+	WaitForTaskSuccessWithContext(ctx context.Context, taskUID int64, interval time.Duration) (*Task, error)
+
+	// WaitForTasks waits for several tasks to complete, polling them with a single tasks query, an unknown task fails with ErrTaskNotFound.
+	WaitForTasks(taskUIDs []int64, opts *WaitForTasksOptions) ([]Task, error)
+
+	// WaitForTasksWithContext waits for several tasks to complete, polling them with a single tasks query, with a context for cancellation.
+	WaitForTasksWithContext(ctx context.Context, taskUIDs []int64, opts *WaitForTasksOptions) ([]Task, error)
+
+	// WatchTasks starts a TaskWatcher streaming the changes of status of the tasks.
+	WatchTasks(opts *TaskWatcherOptions) *TaskWatcher
+
+	// WatchTasksWithContext starts a TaskWatcher streaming the changes of status of the tasks, running until the context is done.
+	WatchTasksWithContext(ctx context.Context, opts *TaskWatcherOptions) *TaskWatcher
+
 	// SwapIndexes swaps the positions of two indexes.
 	SwapIndexes(param []*SwapIndexesParams) (*TaskInfo, error)
 
@@ -167,19 +203,36 @@ type ServiceManager interface {
 
 // New create new service manager for operating on meilisearch
 func New(host string, options ...Option) ServiceManager {
-	defOpt := defaultMeiliOpt
+	return &meilisearch{
+		client: newClientWithOptions(host, newMeiliOpt(options...)),
+	}
+}
 
-	for _, opt := range options {
-		opt(defOpt)
+// NewCluster create new service manager operating on several meilisearch hosts. The first host
+// is the primary one receiving every write request, while read requests such as searches and
+// document fetches are balanced across all the healthy hosts. Hosts are ejected when they cannot
+// be reached and put back once their health endpoint answers again.
+//
+// Close must be called to stop the health checks.
+func NewCluster(hosts []string, options ...Option) (ServiceManager, error) {
+	if len(hosts) == 0 {
+		return nil, ErrNoClusterHosts
 	}
 
+	opt := newMeiliOpt(options...)
+
+	cli := newClientWithOptions(hosts[0], opt)
+	cli.hostPool = newHostPool(hosts, opt.loadBalancing, opt.healthCheckInterval)
+
+	go cli.hostPool.watch(func(ctx context.Context, host string) bool {
+		meili := &meilisearch{client: newClient(cli.client, host, cli.apiKey)}
+		res, err := meili.HealthWithContext(ctx)
+		return err == nil && res.Status == "available"
+	})
+
 	return &meilisearch{
-		client: newClient(
-			defOpt.client,
-			host,
-			defOpt.apiKey,
-		),
-	}
+		client: cli,
+	}, nil
 }
 
 // Connect create service manager and check connection with meilisearch
@@ -347,6 +400,7 @@ func (m *meilisearch) MultiSearchWithContext(ctx context.Context, queries *Multi
 		withRequest:         queries,
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusOK},
+		readOnly:            true,
 		functionName:        "MultiSearch",
 	}
 
@@ -499,6 +553,14 @@ func (m *meilisearch) GetTasksWithContext(ctx context.Context, param *TasksQuery
 	return resp, nil
 }
 
+func (m *meilisearch) TasksIterator(param *TasksQuery) *TasksIterator {
+	return m.TasksIteratorWithContext(context.Background(), param)
+}
+
+func (m *meilisearch) TasksIteratorWithContext(ctx context.Context, param *TasksQuery) *TasksIterator {
+	return newTasksIterator(ctx, m.GetTasksWithContext, param)
+}
+
 func (m *meilisearch) CancelTasks(param *CancelTasksQuery) (*TaskInfo, error) {
 	return m.CancelTasksWithContext(context.Background(), param)
 }
@@ -570,6 +632,65 @@ func (m *meilisearch) DeleteTasksWithContext(ctx context.Context, param *DeleteT
 	return resp, nil
 }
 
+func (m *meilisearch) GetBatch(batchUID int64) (*Batch, error) {
+	return m.GetBatchWithContext(context.Background(), batchUID)
+}
+
+func (m *meilisearch) GetBatchWithContext(ctx context.Context, batchUID int64) (*Batch, error) {
+	resp := new(Batch)
+	req := &internalRequest{
+		endpoint:            "/batches/" + strconv.FormatInt(batchUID, 10),
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetBatch",
+	}
+	if err := m.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m *meilisearch) GetBatches(param *BatchesQuery) (*BatchResult, error) {
+	return m.GetBatchesWithContext(context.Background(), param)
+}
+
+func (m *meilisearch) GetBatchesWithContext(ctx context.Context, param *BatchesQuery) (*BatchResult, error) {
+	resp := new(BatchResult)
+	req := &internalRequest{
+		endpoint:            "/batches",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		withQueryParams:     map[string]string{},
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetBatches",
+	}
+	if param != nil {
+		paramToSend := &TasksQuery{
+			UIDS:             param.UIDS,
+			Limit:            param.Limit,
+			From:             param.From,
+			IndexUIDS:        param.IndexUIDS,
+			Statuses:         param.Statuses,
+			Types:            param.Types,
+			CanceledBy:       param.CanceledBy,
+			BeforeEnqueuedAt: param.BeforeEnqueuedAt,
+			AfterEnqueuedAt:  param.AfterEnqueuedAt,
+			BeforeStartedAt:  param.BeforeStartedAt,
+			AfterStartedAt:   param.AfterStartedAt,
+			BeforeFinishedAt: param.BeforeFinishedAt,
+			AfterFinishedAt:  param.AfterFinishedAt,
+		}
+		encodeTasksQuery(paramToSend, req)
+	}
+	if err := m.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (m *meilisearch) SwapIndexes(param []*SwapIndexesParams) (*TaskInfo, error) {
 	return m.SwapIndexesWithContext(context.Background(), param)
 }
@@ -599,6 +720,30 @@ func (m *meilisearch) WaitForTaskWithContext(ctx context.Context, taskUID int64,
 	return waitForTask(ctx, m.client, taskUID, interval)
 }
 
+func (m *meilisearch) WaitForTaskSuccess(taskUID int64, interval time.Duration) (*Task, error) {
+	return waitForTaskSuccess(context.Background(), m.client, taskUID, interval)
+}
+
+func (m *meilisearch) WaitForTaskSuccessWithContext(ctx context.Context, taskUID int64, interval time.Duration) (*Task, error) {
+	return waitForTaskSuccess(ctx, m.client, taskUID, interval)
+}
+
+func (m *meilisearch) WaitForTasks(taskUIDs []int64, opts *WaitForTasksOptions) ([]Task, error) {
+	return waitForTasks(context.Background(), m.client, taskUIDs, opts)
+}
+
+func (m *meilisearch) WaitForTasksWithContext(ctx context.Context, taskUIDs []int64, opts *WaitForTasksOptions) ([]Task, error) {
+	return waitForTasks(ctx, m.client, taskUIDs, opts)
+}
+
+func (m *meilisearch) WatchTasks(opts *TaskWatcherOptions) *TaskWatcher {
+	return watchTasks(context.Background(), m.client, opts)
+}
+
+func (m *meilisearch) WatchTasksWithContext(ctx context.Context, opts *TaskWatcherOptions) *TaskWatcher {
+	return watchTasks(ctx, m.client, opts)
+}
+
 func (m *meilisearch) GenerateTenantToken(
 	apiKeyUID string,
 	searchRules map[string]interface{},
@@ -760,6 +905,9 @@ func (m *meilisearch) IsHealthy() bool {
 }
 
 func (m *meilisearch) Close() {
+	if m.client.hostPool != nil {
+		m.client.hostPool.close()
+	}
 	m.client.client.CloseIdleConnections()
 }
 
@@ -779,63 +927,39 @@ func getTask(ctx context.Context, cli *client, taskUID int64) (*Task, error) {
 	return resp, nil
 }
 
-func waitForTask(ctx context.Context, cli *client, taskUID int64, interval time.Duration) (*Task, error) {
-	if interval == 0 {
-		interval = 50 * time.Millisecond
-	}
-
-	// extract closure to get the task and check the status first before the ticker
-	fn := func() (*Task, error) {
-		getTask, err := getTask(ctx, cli, taskUID)
-		if err != nil {
-			return nil, err
-		}
-
-		if getTask.Status != TaskStatusEnqueued && getTask.Status != TaskStatusProcessing {
-			return getTask, nil
-		}
-		return nil, nil
-	}
-
-	// run first before the ticker, we do not want to wait for the first interval
-	task, err := fn()
+// waitForTaskSuccess waits for a task and turns its failure or cancelation into a *TaskFailedError.
+func waitForTaskSuccess(ctx context.Context, cli *client, taskUID int64, interval time.Duration) (*Task, error) {
+	task, err := waitForTask(ctx, cli, taskUID, interval)
 	if err != nil {
-		// Return error if it exists
 		return nil, err
 	}
-
-	// Return task if it exists
-	if task != nil {
-		return task, nil
+	if task.Status == TaskStatusFailed || task.Status == TaskStatusCanceled {
+		return nil, &TaskFailedError{Task: task}
 	}
+	return task, nil
+}
 
-	// Create a ticker to check the task status, because our initial check was not successful
-	ticker := time.NewTicker(interval)
-
-	// Defer the stop of the ticker, help GC to cleanup
+func waitForTask(ctx context.Context, cli *client, taskUID int64, interval time.Duration) (task *Task, err error) {
+	start := time.Now()
 	defer func() {
-		// we might want to revist this, go.mod now is 1.16
-		// however I still encouter the issue on go 1.22.2
-		// there are 2 issues regarding tickers
-		// https://go-review.googlesource.com/c/go/+/512355
-		// https://github.com/golang/go/issues/61542
-		ticker.Stop()
-		ticker = nil
+		cli.observeTaskWait(taskUID, start, task, err)
 	}()
 
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-ticker.C:
-			task, err := fn()
-			if err != nil {
-				return nil, err
-			}
-
-			if task != nil {
-				return task, nil
-			}
+	strategy := cli.pollStrategy(interval)
+
+	// the task is polled right away, we do not want to wait for the first interval
+	for attempt := 1; ; attempt++ {
+		task, err = getTask(ctx, cli, taskUID)
+		if err != nil {
+			return nil, err
+		}
+
+		if task.Status != TaskStatusEnqueued && task.Status != TaskStatusProcessing {
+			return task, nil
+		}
+
+		if err := sleepContext(ctx, nextPollInterval(strategy, attempt, task)); err != nil {
+			return nil, err
 		}
 	}
 }
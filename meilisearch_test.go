@@ -5,6 +5,8 @@ import (
 	"crypto/tls"
 	"errors"
 	"math"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"sync"
 	"testing"
@@ -56,7 +58,7 @@ func TestClient_TimeoutError(t *testing.T) {
 			name: "TestTimeoutError",
 			sv:   sv,
 			expectedError: Error{
-				MeilisearchApiError: meilisearchApiError{},
+				MeilisearchApiError: APIError{},
 			},
 		},
 	}
@@ -1313,7 +1315,7 @@ func Test_CancelTasks(t *testing.T) {
 			gotResp, err := c.CancelTasks(tt.args.query)
 			if tt.args.query == nil {
 				require.Error(t, err)
-				require.Equal(t, "missing_task_filters",
+				require.Equal(t, APIErrorCodeMissingTaskFilters,
 					err.(*Error).MeilisearchApiError.Code)
 			} else {
 				require.NoError(t, err)
@@ -2141,3 +2143,105 @@ func Test_CreateSnapshot(t *testing.T) {
 	require.NoError(t, err)
 	testWaitForTask(t, c.Index("indexUID"), task)
 }
+
+func TestWaitForTaskSuccess(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tasks/1":
+			_, _ = w.Write([]byte(`{"uid":1,"status":"succeeded","type":"documentAdditionOrUpdate"}`))
+		case "/tasks/2":
+			_, _ = w.Write([]byte(`{"uid":2,"status":"failed","type":"documentAdditionOrUpdate",` +
+				`"error":{"message":"Index ` + "`movies`" + ` not found.","code":"index_not_found","type":"invalid_request","link":""}}`))
+		case "/tasks/3":
+			_, _ = w.Write([]byte(`{"uid":3,"status":"canceled","type":"settingsUpdate","canceledBy":4}`))
+		}
+	}))
+	defer ts.Close()
+
+	meili := New(ts.URL)
+
+	task, err := meili.WaitForTaskSuccess(1, 0)
+	require.NoError(t, err)
+	require.Equal(t, TaskStatusSucceeded, task.Status)
+
+	task, err = meili.Index("movies").WaitForTaskSuccess(2, 0)
+	require.Nil(t, task)
+	var failedErr *TaskFailedError
+	require.ErrorAs(t, err, &failedErr)
+	require.Equal(t, int64(2), failedErr.Task.UID)
+	require.Equal(t, APIErrorCodeIndexNotFound, failedErr.Code())
+	require.ErrorIs(t, err, ErrIndexNotFound)
+	var meiliErr *Error
+	require.ErrorAs(t, err, &meiliErr)
+	require.Equal(t, MeilisearchTaskError, meiliErr.ErrCode)
+
+	_, err = meili.WaitForTaskSuccessWithContext(context.Background(), 3, 0)
+	require.ErrorAs(t, err, &failedErr)
+	require.Equal(t, TaskStatusCanceled, failedErr.Task.Status)
+	require.Empty(t, failedErr.Code())
+	require.EqualError(t, err, "task 3 of type settingsUpdate was canceled by task 4")
+}
+
+func TestGetBatches(t *testing.T) {
+	var queries []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/batches/3":
+			_, _ = w.Write([]byte(`{"uid":3,"progress":{"steps":[{"currentStep":"processing tasks","finished":0,"total":2},` +
+				`{"currentStep":"indexing","finished":1,"total":3}],"percentage":16.666668},"details":{"receivedDocuments":2000},` +
+				`"stats":{"totalNbTasks":2,"status":{"processing":2},"types":{"documentAdditionOrUpdate":2},"indexUids":{"movies":2}},` +
+				`"duration":null,"startedAt":"2024-12-10T15:48:00.117Z","finishedAt":null}`))
+		case "/batches/4":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Batch 4 not found.","code":"batch_not_found","type":"invalid_request","link":""}`))
+		case "/batches":
+			queries = append(queries, r.URL.RawQuery)
+			_, _ = w.Write([]byte(`{"results":[{"uid":2,"details":{},"stats":{"totalNbTasks":1,"status":{"succeeded":1},` +
+				`"types":{"indexCreation":1},"indexUids":{"movies":1},"progressTrace":{"processing tasks":"1.21ms"}},` +
+				`"duration":"PT0.001S","startedAt":"2024-12-10T15:20:30.18Z","finishedAt":"2024-12-10T15:20:30.19Z"}],` +
+				`"total":3,"limit":1,"from":2,"next":1}`))
+		case "/tasks/5":
+			_, _ = w.Write([]byte(`{"uid":5,"batchUid":0,"status":"succeeded","type":"documentAdditionOrUpdate"}`))
+		case "/tasks/6":
+			_, _ = w.Write([]byte(`{"uid":6,"batchUid":null,"status":"enqueued","type":"documentAdditionOrUpdate"}`))
+		}
+	}))
+	defer ts.Close()
+
+	meili := New(ts.URL)
+
+	batch, err := meili.GetBatch(3)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), batch.UID)
+	require.NotNil(t, batch.Progress)
+	require.Len(t, batch.Progress.Steps, 2)
+	require.Equal(t, "indexing", batch.Progress.Steps[1].CurrentStep)
+	require.Equal(t, int64(2000), batch.Details.ReceivedDocuments)
+	require.Equal(t, map[TaskStatus]int64{TaskStatusProcessing: 2}, batch.Stats.Status)
+	require.True(t, batch.FinishedAt.IsZero())
+
+	_, err = meili.GetBatchWithContext(context.Background(), 4)
+	require.ErrorIs(t, err, ErrBatchNotFound)
+
+	batches, err := meili.GetBatches(&BatchesQuery{
+		Limit:     1,
+		From:      2,
+		IndexUIDS: []string{"movies"},
+		Statuses:  []TaskStatus{TaskStatusSucceeded},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"from=2&indexUids=movies&limit=1&statuses=succeeded"}, queries)
+	require.Len(t, batches.Results, 1)
+	require.Equal(t, int64(1), batches.Next)
+	require.Equal(t, map[TaskType]int64{TaskTypeIndexCreation: 1}, batches.Results[0].Stats.Types)
+	require.Equal(t, "1.21ms", batches.Results[0].Stats.ProgressTrace["processing tasks"])
+
+	task, err := meili.GetTask(5)
+	require.NoError(t, err)
+	require.NotNil(t, task.BatchUID)
+	require.Equal(t, int64(0), *task.BatchUID)
+
+	task, err = meili.GetTask(6)
+	require.NoError(t, err)
+	require.Nil(t, task.BatchUID)
+}
@@ -0,0 +1,90 @@
+package meilisearch
+
+import (
+	"errors"
+	"time"
+)
+
+// MetricsRecorder receives the measures taken by the client, it is installed with
+// WithMetricsRecorder. Implementations must be safe for concurrent use.
+type MetricsRecorder interface {
+	// ObserveRequest is called once every request sent by the client is done.
+	ObserveRequest(metrics RequestMetrics)
+
+	// ObserveTaskWait is called once WaitForTask returns.
+	ObserveTaskWait(metrics TaskWaitMetrics)
+}
+
+// RequestMetrics describes a request sent by the client.
+type RequestMetrics struct {
+	// Function is the name of the method sending the request, e.g. "Search".
+	Function string
+
+	// Method is the HTTP verb of the request.
+	Method string
+
+	// StatusCode of the response, 0 when no response was received.
+	StatusCode int
+
+	// Duration of the request, retries included.
+	Duration time.Duration
+
+	// BytesSent is the size of the request body, summed over the retries.
+	BytesSent int64
+
+	// BytesReceived is the size of the response body, 0 for the bodies returned as a stream,
+	// such as the one of SearchStream.
+	BytesReceived int64
+
+	// Err is the error returned by the request, nil on success.
+	Err error
+
+	// ErrCode is the code of Err when it is an *Error, ErrCodeUnknown otherwise.
+	ErrCode ErrCode
+
+	// APIErrorCode is the code sent by Meilisearch along with an error response.
+	APIErrorCode APIErrorCode
+}
+
+// TaskWaitMetrics describes a wait for a task to be processed.
+type TaskWaitMetrics struct {
+	// TaskUID is the uid of the awaited task.
+	TaskUID int64
+
+	// Status of the task once the wait is over, TaskStatusUnknown when it could not be fetched.
+	Status TaskStatus
+
+	// Duration of the wait.
+	Duration time.Duration
+
+	// Err is the error returned by the wait, nil on success.
+	Err error
+}
+
+func (m *RequestMetrics) observeError(err error) {
+	m.Err = err
+
+	var meiliErr *Error
+	if errors.As(err, &meiliErr) {
+		m.ErrCode = meiliErr.ErrCode
+		m.APIErrorCode = meiliErr.MeilisearchApiError.Code
+	}
+}
+
+// observeTaskWait reports a wait for a task to the metrics recorder of the client, if any.
+func (c *client) observeTaskWait(taskUID int64, start time.Time, task *Task, err error) {
+	if c.metrics == nil {
+		return
+	}
+
+	metrics := TaskWaitMetrics{
+		TaskUID:  taskUID,
+		Status:   TaskStatusUnknown,
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	if task != nil {
+		metrics.Status = task.Status
+	}
+	c.metrics.ObserveTaskWait(metrics)
+}
@@ -0,0 +1,106 @@
+package meilisearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockMetricsRecorder struct {
+	mu        sync.Mutex
+	requests  []RequestMetrics
+	taskWaits []TaskWaitMetrics
+}
+
+func (r *mockMetricsRecorder) ObserveRequest(metrics RequestMetrics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, metrics)
+}
+
+func (r *mockMetricsRecorder) ObserveTaskWait(metrics TaskWaitMetrics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.taskWaits = append(r.taskWaits, metrics)
+}
+
+func TestMetricsRecorder(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/indexes/movies/documents":
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"taskUid":1}`))
+		case "/tasks/1":
+			_, _ = w.Write([]byte(`{"uid":1,"status":"succeeded"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index not found","code":"index_not_found"}`))
+		}
+	}))
+	defer ts.Close()
+
+	recorder := &mockMetricsRecorder{}
+	c := newClient(&http.Client{}, ts.URL, "")
+	c.metrics = recorder
+	meili := &meilisearch{client: c}
+	idx := meili.Index("movies")
+
+	documents := `{"id":1}` + "\n"
+	_, err := idx.AddDocumentsNdjsonFromReader(strings.NewReader(documents))
+	require.NoError(t, err)
+	_, err = meili.WaitForTask(1, 0)
+	require.NoError(t, err)
+	_, err = meili.GetIndex("unknown")
+	require.Error(t, err)
+
+	require.Len(t, recorder.requests, 3)
+
+	addDocuments := recorder.requests[0]
+	require.Equal(t, "AddDocuments", addDocuments.Function)
+	require.Equal(t, http.MethodPost, addDocuments.Method)
+	require.Equal(t, http.StatusAccepted, addDocuments.StatusCode)
+	require.Equal(t, int64(len(documents)), addDocuments.BytesSent)
+	require.Equal(t, int64(len(`{"taskUid":1}`)), addDocuments.BytesReceived)
+	require.NoError(t, addDocuments.Err)
+
+	getTask := recorder.requests[1]
+	require.Equal(t, "GetTask", getTask.Function)
+	require.Zero(t, getTask.BytesSent)
+
+	fetchInfo := recorder.requests[2]
+	require.Equal(t, "FetchInfo", fetchInfo.Function)
+	require.Equal(t, http.StatusNotFound, fetchInfo.StatusCode)
+	require.Error(t, fetchInfo.Err)
+	require.Equal(t, MeilisearchApiError, fetchInfo.ErrCode)
+	require.Equal(t, APIErrorCodeIndexNotFound, fetchInfo.APIErrorCode)
+
+	require.Len(t, recorder.taskWaits, 1)
+	require.Equal(t, int64(1), recorder.taskWaits[0].TaskUID)
+	require.Equal(t, TaskStatusSucceeded, recorder.taskWaits[0].Status)
+	require.NoError(t, recorder.taskWaits[0].Err)
+}
+
+func TestExecuteRequest_ReaderContentLength(t *testing.T) {
+	var contentLength int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentLength = r.ContentLength
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer ts.Close()
+
+	c := newClient(&http.Client{}, ts.URL, "")
+	err := c.executeRequest(context.Background(), &internalRequest{
+		endpoint:            "/indexes/movies/documents",
+		method:              http.MethodPost,
+		contentType:         contentTypeNDJSON,
+		withRequest:         strings.NewReader(`{"id":1}`),
+		acceptedStatusCodes: []int{http.StatusAccepted},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(len(`{"id":1}`)), contentLength)
+}
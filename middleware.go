@@ -0,0 +1,85 @@
+package meilisearch
+
+import (
+	"context"
+	"net/http"
+)
+
+// Request is the view of an outgoing request given to a Middleware. A middleware can modify it
+// before calling the next Handler, for instance to add headers or rewrite the endpoint.
+type Request struct {
+	// Function is the name of the method sending the request, e.g. "Search" or "AddDocuments".
+	Function string
+
+	// Method is the HTTP verb of the request.
+	Method string
+
+	// Endpoint is the path of the request, the host is not in.
+	Endpoint string
+
+	// Query holds the query parameters of the request.
+	Query map[string]string
+
+	// ContentType of the body, empty when the request has no body.
+	ContentType string
+
+	// Body is the value sent as request body: a []byte, an io.Reader or a value encoded to JSON.
+	Body interface{}
+
+	// Header holds the additional headers sent with the request.
+	Header http.Header
+}
+
+// Response is the view of a response given back to a Middleware.
+type Response struct {
+	// StatusCode of the response.
+	StatusCode int
+
+	// Header holds the headers of the response.
+	Header http.Header
+
+	// RequestHeader holds the headers of the request as it was sent, the ones set by the client
+	// such as Authorization included.
+	RequestHeader http.Header
+
+	// Result is the value the response body was decoded into, nil when the request failed or
+	// when the method does not decode the response.
+	Result interface{}
+}
+
+// Handler sends a request to Meilisearch. The returned Response is nil when no response was
+// received, it can come along with an error when the response has an unexpected status code.
+type Handler func(ctx context.Context, req *Request) (*Response, error)
+
+// Middleware wraps a Handler to add a cross-cutting behavior to every request sent by the client,
+// such as logging, metrics or additional headers.
+type Middleware func(next Handler) Handler
+
+func newRequest(req *internalRequest) *Request {
+	request := &Request{
+		Function:    req.functionName,
+		Method:      req.method,
+		Endpoint:    req.endpoint,
+		Query:       make(map[string]string, len(req.withQueryParams)),
+		ContentType: req.contentType,
+		Body:        req.withRequest,
+		Header:      http.Header{},
+	}
+	for key, value := range req.withQueryParams {
+		request.Query[key] = value
+	}
+	for key, values := range req.withHeaders {
+		request.Header[key] = values
+	}
+	return request
+}
+
+// applyTo copies the fields a middleware may have changed back into req.
+func (r *Request) applyTo(req *internalRequest) {
+	req.method = r.Method
+	req.endpoint = r.Endpoint
+	req.contentType = r.ContentType
+	req.withRequest = r.Body
+	req.withQueryParams = r.Query
+	req.withHeaders = r.Header
+}
@@ -0,0 +1,81 @@
+package meilisearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteRequest_Middleware(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Tenant") != "acme" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"missing tenant","code":"missing_authorization_header"}`))
+			return
+		}
+		if r.URL.Path == "/indexes/acme-movies/search" {
+			_, _ = w.Write([]byte(`{"hits":[],"processingTimeMs":3,"query":"carol"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				calls = append(calls, name+" "+req.Function+" "+req.Method+" "+req.Endpoint)
+				resp, err := next(ctx, req)
+				if resp != nil {
+					calls = append(calls, name+" status "+http.StatusText(resp.StatusCode))
+				}
+				return resp, err
+			}
+		}
+	}
+	tenant := func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			req.Header.Set("X-Tenant", "acme")
+			req.Endpoint = "/indexes/acme-movies/search"
+			return next(ctx, req)
+		}
+	}
+
+	var result interface{}
+	capture := func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			resp, err := next(ctx, req)
+			if resp != nil {
+				result = resp.Result
+			}
+			return resp, err
+		}
+	}
+
+	c := newClient(&http.Client{}, ts.URL, "")
+	c.middlewares = []Middleware{trace("outer"), trace("inner"), capture, tenant}
+
+	res, err := newIndex(c, "movies").Search("carol", &SearchRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "carol", res.Query)
+	require.Equal(t, res, result)
+	require.Equal(t, []string{
+		"outer Search POST /indexes/movies/search",
+		"inner Search POST /indexes/movies/search",
+		"inner status OK",
+		"outer status OK",
+	}, calls)
+
+	calls = nil
+	c.middlewares = []Middleware{trace("outer")}
+	_, err = newIndex(c, "movies").Search("carol", &SearchRequest{})
+	require.Error(t, err)
+	require.Equal(t, []string{
+		"outer Search POST /indexes/movies/search",
+		"outer status Unauthorized",
+	}, calls)
+}
@@ -12,16 +12,43 @@ var (
 		client: &http.Client{
 			Transport: baseTransport(),
 		},
+		errorBodyLimit: defaultErrorBodyLimit,
 	}
 )
 
 type meiliOpt struct {
-	client *http.Client
-	apiKey string
+	client              *http.Client
+	apiKey              string
+	retryPolicy         *RetryPolicy
+	loadBalancing       LoadBalancingStrategy
+	healthCheckInterval time.Duration
+	middlewares         []Middleware
+	metrics             MetricsRecorder
+	contentEncoding     ContentEncoding
+	marshal             JSONMarshal
+	unmarshal           JSONUnmarshal
+	errorBodyLimit      int
+	errorRedactedFields []string
+	excludeErrorBodies  bool
+	pollStrategy        PollStrategy
 }
 
 type Option func(*meiliOpt)
 
+// newMeiliOpt applies options to a copy of defaultMeiliOpt, so that the options given to a
+// client never leak into the clients created after it.
+func newMeiliOpt(options ...Option) *meiliOpt {
+	opt := *defaultMeiliOpt
+	opt.middlewares = append([]Middleware(nil), defaultMeiliOpt.middlewares...)
+	opt.errorRedactedFields = append([]string(nil), defaultMeiliOpt.errorRedactedFields...)
+
+	for _, option := range options {
+		option(&opt)
+	}
+
+	return &opt
+}
+
 // WithCustomClient set custom http.Client
 func WithCustomClient(client *http.Client) Option {
 	return func(opt *meiliOpt) {
@@ -46,6 +73,117 @@ func WithAPIKey(key string) Option {
 	}
 }
 
+// WithRetryPolicy retries the requests failing with a transient error according to policy,
+// use DefaultRetryPolicy for sensible defaults. A nil policy disables retries.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(opt *meiliOpt) {
+		opt.retryPolicy = policy
+	}
+}
+
+// WithLoadBalancing set how a cluster created by NewCluster spreads the read requests
+// across its hosts, RoundRobin by default.
+func WithLoadBalancing(strategy LoadBalancingStrategy) Option {
+	return func(opt *meiliOpt) {
+		opt.loadBalancing = strategy
+	}
+}
+
+// WithHealthCheckInterval set how often a cluster created by NewCluster checks the health of
+// its hosts, every 10 seconds by default.
+func WithHealthCheckInterval(interval time.Duration) Option {
+	return func(opt *meiliOpt) {
+		opt.healthCheckInterval = interval
+	}
+}
+
+// WithMiddleware add middlewares wrapping every request sent by the client, the first
+// middleware given is the outermost one.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(opt *meiliOpt) {
+		opt.middlewares = append(opt.middlewares, middlewares...)
+	}
+}
+
+// WithMetricsRecorder report the requests sent by the client and the task waits to recorder.
+func WithMetricsRecorder(recorder MetricsRecorder) Option {
+	return func(opt *meiliOpt) {
+		opt.metrics = recorder
+	}
+}
+
+// WithContentEncoding compresses the request bodies with encoding, such as the documents sent
+// to an index. The bodies are compressed while they are sent, use ContextWithContentEncoding to
+// override the encoding for a single call.
+func WithContentEncoding(encoding ContentEncoding) Option {
+	return func(opt *meiliOpt) {
+		opt.contentEncoding = encoding
+	}
+}
+
+// JSONMarshal encodes v to JSON, it has the signature of json.Marshal.
+type JSONMarshal func(v interface{}) ([]byte, error)
+
+// JSONUnmarshal decodes data into v, it has the signature of json.Unmarshal.
+type JSONUnmarshal func(data []byte, v interface{}) error
+
+// WithJSONMarshaler encodes the request bodies with marshal instead of encoding/json, e.g.
+// with the Marshal function of goccy/go-json or sonic. The request types of this package are
+// given to marshal as pointers to structs with the same fields and json tags but without their
+// easyjson MarshalJSON method, so that marshal encodes their fields itself.
+func WithJSONMarshaler(marshal JSONMarshal) Option {
+	return func(opt *meiliOpt) {
+		opt.marshal = marshal
+	}
+}
+
+// WithJSONUnmarshaler decodes the response bodies with unmarshal instead of encoding/json, e.g.
+// with the Unmarshal function of goccy/go-json or sonic. The response types of this package, such
+// as SearchResponse or TaskInfo, are given to unmarshal as pointers to structs with the same
+// fields and json tags but without their easyjson UnmarshalJSON method, so that unmarshal decodes
+// their fields itself. Their fields whose type is one of the package keep their method. As
+// unmarshal takes bytes, the response bodies are read whole in memory before being decoded, rather
+// than decoded as they are received.
+func WithJSONUnmarshaler(unmarshal JSONUnmarshal) Option {
+	return func(opt *meiliOpt) {
+		opt.unmarshal = unmarshal
+	}
+}
+
+// WithErrorBodyLimit caps the number of bytes of the request and response bodies kept in the
+// RequestToString and ResponseToString fields of an *Error, 64 KiB by default. A limit <= 0
+// keeps the bodies whole.
+func WithErrorBodyLimit(limit int) Option {
+	return func(opt *meiliOpt) {
+		opt.errorBodyLimit = limit
+	}
+}
+
+// WithErrorRedactedFields hides the values of the JSON fields named fields in the bodies kept in
+// an *Error, e.g. the fields of the documents holding personal data. Objects and arrays are hidden
+// whole. The API keys are always hidden.
+func WithErrorRedactedFields(fields ...string) Option {
+	return func(opt *meiliOpt) {
+		opt.errorRedactedFields = append(opt.errorRedactedFields, fields...)
+	}
+}
+
+// WithoutErrorBodies keeps the request and response bodies out of an *Error, the error sent by
+// Meilisearch is still decoded into MeilisearchApiError.
+func WithoutErrorBodies() Option {
+	return func(opt *meiliOpt) {
+		opt.excludeErrorBodies = true
+	}
+}
+
+// WithPollStrategy set how often the tasks are polled while waiting for them to be processed,
+// every 50ms by default. An interval given to WaitForTask takes precedence over strategy.
+func WithPollStrategy(strategy PollStrategy) Option {
+	return func(opt *meiliOpt) {
+		opt.pollStrategy = strategy
+	}
+}
+
 func baseTransport() *http.Transport {
 	return &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
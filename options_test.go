@@ -1,8 +1,15 @@
 package meilisearch
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"github.com/stretchr/testify/require"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
 	"testing"
 )
 
@@ -12,3 +19,96 @@ func TestOptions_WithCustomClient(t *testing.T) {
 	require.NoError(t, err)
 	require.NotZero(t, v.PkgVersion)
 }
+
+type countingTransport struct {
+	mu    sync.Mutex
+	count int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.count++
+	t.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestOptions_PerClientIsolation(t *testing.T) {
+	var mu sync.Mutex
+	var authorizations []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"status":"available"}`))
+	}))
+	defer ts.Close()
+
+	var middlewareCalls int
+	transportA, transportB := &countingTransport{}, &countingTransport{}
+	clientA := New(ts.URL, WithAPIKey("tenant-a"), WithCustomClient(&http.Client{Transport: transportA}),
+		WithMiddleware(func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				middlewareCalls++
+				return next(ctx, req)
+			}
+		}))
+	clientB := New(ts.URL, WithAPIKey("tenant-b"), WithCustomClient(&http.Client{Transport: transportB}))
+	clientC := New(ts.URL)
+
+	for _, meili := range []ServiceManager{clientA, clientB, clientC, clientA} {
+		_, err := meili.Health()
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, []string{"Bearer tenant-a", "Bearer tenant-b", "", "Bearer tenant-a"}, authorizations)
+	require.Equal(t, 2, transportA.count)
+	require.Equal(t, 1, transportB.count)
+	require.Equal(t, 2, middlewareCalls)
+
+	require.Empty(t, defaultMeiliOpt.apiKey)
+	require.Empty(t, defaultMeiliOpt.middlewares)
+	require.NotSame(t, transportA, defaultMeiliOpt.client.Transport)
+}
+
+func TestOptions_JSONCodec(t *testing.T) {
+	var requestBody string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requestBody = string(b)
+		_, _ = w.Write([]byte(`{"hits":[{"id":1}],"query":"carol","processingTimeMs":1}`))
+	}))
+	defer ts.Close()
+
+	var marshaled, unmarshaled []interface{}
+	meili := New(ts.URL,
+		WithJSONMarshaler(func(v interface{}) ([]byte, error) {
+			marshaled = append(marshaled, v)
+			return json.Marshal(v)
+		}),
+		WithJSONUnmarshaler(func(data []byte, v interface{}) error {
+			unmarshaled = append(unmarshaled, v)
+			return json.Unmarshal(data, v)
+		}))
+
+	res, err := meili.Index("movies").Search("carol", &SearchRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, "carol", res.Query)
+	require.Len(t, res.Hits, 1)
+	require.JSONEq(t, `{"q":"carol","limit":1}`, requestBody)
+
+	// the codec is given the fields of the types of the package rather than their easyjson methods
+	require.Len(t, marshaled, 1)
+	_, ok := marshaled[0].(json.Marshaler)
+	require.False(t, ok)
+	require.Len(t, unmarshaled, 1)
+	_, ok = unmarshaled[0].(json.Unmarshaler)
+	require.False(t, ok)
+	require.True(t, reflect.TypeOf(unmarshaled[0]).ConvertibleTo(reflect.TypeOf(&SearchResponse{})))
+
+	_, err = New(ts.URL, WithJSONMarshaler(func(v interface{}) ([]byte, error) {
+		return nil, errors.New("unsupported type")
+	})).Index("movies").Search("carol", &SearchRequest{})
+	var meiliErr *Error
+	require.ErrorAs(t, err, &meiliErr)
+	require.Equal(t, ErrCodeMarshalRequest, meiliErr.ErrCode)
+}
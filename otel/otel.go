@@ -0,0 +1,142 @@
+// Package otel instruments the Meilisearch client with OpenTelemetry tracing.
+//
+// Every request sent by the client is wrapped in a client span named after the SDK method
+// sending it, and the W3C trace context is propagated to Meilisearch through the request headers.
+//
+//	Example:
+//
+//	meili := meilisearch.New("http://localhost:7700",
+//		meilisearch.WithAPIKey("foobar"),
+//		otel.WithTracing(),
+//	)
+package otel
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/meilisearch/meilisearch-go"
+	otelglobal "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/meilisearch/meilisearch-go/otel"
+
+// Attribute keys set on the spans in addition to the HTTP ones.
+const (
+	IndexUIDKey         = attribute.Key("meilisearch.index_uid")
+	TaskUIDKey          = attribute.Key("meilisearch.task_uid")
+	ProcessingTimeMsKey = attribute.Key("meilisearch.processing_time_ms")
+	ErrorCodeKey        = attribute.Key("meilisearch.error_code")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// Option configures the tracing middleware.
+type Option func(*config)
+
+// WithTracerProvider set the TracerProvider creating the spans, the global one by default.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(cfg *config) {
+		cfg.tracerProvider = provider
+	}
+}
+
+// WithPropagator set the propagator injecting the trace context in the request headers, the
+// global one by default.
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(cfg *config) {
+		cfg.propagator = propagator
+	}
+}
+
+// WithTracing is a meilisearch.Option installing the tracing Middleware on the client.
+func WithTracing(options ...Option) meilisearch.Option {
+	return meilisearch.WithMiddleware(Middleware(options...))
+}
+
+// Middleware returns a meilisearch.Middleware creating a span for every request sent by the client.
+func Middleware(options ...Option) meilisearch.Middleware {
+	cfg := &config{
+		tracerProvider: otelglobal.GetTracerProvider(),
+		propagator:     otelglobal.GetTextMapPropagator(),
+	}
+	for _, opt := range options {
+		opt(cfg)
+	}
+
+	tracer := cfg.tracerProvider.Tracer(instrumentationName, trace.WithInstrumentationVersion(meilisearch.VERSION))
+
+	return func(next meilisearch.Handler) meilisearch.Handler {
+		return func(ctx context.Context, req *meilisearch.Request) (*meilisearch.Response, error) {
+			attrs := []attribute.KeyValue{
+				attribute.String("db.system", "meilisearch"),
+				attribute.String("http.request.method", req.Method),
+				attribute.String("url.path", req.Endpoint),
+			}
+			if uid := indexUID(req.Endpoint); uid != "" {
+				attrs = append(attrs, IndexUIDKey.String(uid))
+			}
+
+			ctx, span := tracer.Start(ctx, req.Function,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...),
+			)
+			defer span.End()
+
+			cfg.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+			resp, err := next(ctx, req)
+			if resp != nil {
+				span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+				setResultAttributes(span, resp.Result)
+			}
+			if err != nil {
+				var meiliErr *meilisearch.Error
+				if errors.As(err, &meiliErr) && meiliErr.MeilisearchApiError.Code != "" {
+					span.SetAttributes(ErrorCodeKey.String(string(meiliErr.MeilisearchApiError.Code)))
+				}
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			return resp, err
+		}
+	}
+}
+
+// indexUID extracts the index uid from an endpoint such as /indexes/movies/search.
+func indexUID(endpoint string) string {
+	const prefix = "/indexes/"
+	if !strings.HasPrefix(endpoint, prefix) {
+		return ""
+	}
+	uid := strings.TrimPrefix(endpoint, prefix)
+	if i := strings.IndexAny(uid, "/?"); i >= 0 {
+		uid = uid[:i]
+	}
+	return uid
+}
+
+func setResultAttributes(span trace.Span, result interface{}) {
+	switch res := result.(type) {
+	case *meilisearch.TaskInfo:
+		span.SetAttributes(TaskUIDKey.Int64(res.TaskUID))
+	case **meilisearch.TaskInfo:
+		if *res != nil {
+			span.SetAttributes(TaskUIDKey.Int64((*res).TaskUID))
+		}
+	case *meilisearch.Task:
+		span.SetAttributes(TaskUIDKey.Int64(res.UID))
+	case *meilisearch.SearchResponse:
+		span.SetAttributes(ProcessingTimeMsKey.Int64(res.ProcessingTimeMs))
+	case *meilisearch.MultiSearchResponse:
+		span.SetAttributes(ProcessingTimeMsKey.Int64(res.ProcessingTimeMs))
+	}
+}
@@ -0,0 +1,91 @@
+package otel
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestMiddleware(t *testing.T) {
+	var traceParents []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParents = append(traceParents, r.Header.Get("traceparent"))
+		switch r.URL.Path {
+		case "/indexes/movies/search":
+			_, _ = w.Write([]byte(`{"hits":[],"processingTimeMs":7,"query":"carol"}`))
+		case "/indexes/movies/documents":
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"taskUid":42,"indexUid":"movies","status":"enqueued"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index not found","code":"index_not_found","type":"invalid_request"}`))
+		}
+	}))
+	defer ts.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	meili := meilisearch.New(ts.URL, WithTracing(
+		WithTracerProvider(provider),
+		WithPropagator(propagation.TraceContext{}),
+	))
+	idx := meili.Index("movies")
+
+	_, err := idx.Search("carol", &meilisearch.SearchRequest{})
+	require.NoError(t, err)
+	_, err = idx.AddDocuments([]map[string]interface{}{{"id": 1}})
+	require.NoError(t, err)
+	_, err = meili.GetIndex("unknown")
+	require.Error(t, err)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+
+	search := spans[0]
+	require.Equal(t, "Search", search.Name)
+	requireAttribute(t, search.Attributes, IndexUIDKey.String("movies"))
+	requireAttribute(t, search.Attributes, ProcessingTimeMsKey.Int64(7))
+	requireAttribute(t, search.Attributes, attribute.Int("http.response.status_code", http.StatusOK))
+
+	addDocuments := spans[1]
+	require.Equal(t, "AddDocuments", addDocuments.Name)
+	requireAttribute(t, addDocuments.Attributes, TaskUIDKey.Int64(42))
+
+	getIndex := spans[2]
+	require.Equal(t, "FetchInfo", getIndex.Name)
+	require.Equal(t, codes.Error, getIndex.Status.Code)
+	requireAttribute(t, getIndex.Attributes, ErrorCodeKey.String("index_not_found"))
+
+	require.Len(t, traceParents, 3)
+	for i, traceParent := range traceParents {
+		require.Contains(t, traceParent, spans[i].SpanContext.TraceID().String())
+		require.Contains(t, traceParent, spans[i].SpanContext.SpanID().String())
+	}
+}
+
+func TestIndexUID(t *testing.T) {
+	require.Equal(t, "movies", indexUID("/indexes/movies"))
+	require.Equal(t, "movies", indexUID("/indexes/movies/documents?primaryKey=id"))
+	require.Equal(t, "movies", indexUID("/indexes/movies?primaryKey=id"))
+	require.Equal(t, "", indexUID("/tasks"))
+}
+
+func requireAttribute(t *testing.T, attrs []attribute.KeyValue, want attribute.KeyValue) {
+	t.Helper()
+	for _, attr := range attrs {
+		if attr.Key == want.Key {
+			require.Equal(t, want.Value, attr.Value)
+			return
+		}
+	}
+	t.Fatalf("attribute %s not found in %v", want.Key, attrs)
+}
@@ -0,0 +1,143 @@
+package meilisearch
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// defaultPollInterval is the interval between two polls of a task when none is configured.
+	defaultPollInterval = 50 * time.Millisecond
+
+	// defaultExponentialMaxInterval is the MaxInterval of an ExponentialPollStrategy when it is
+	// not set.
+	defaultExponentialMaxInterval = 2 * time.Second
+
+	// defaultDocumentsEstimateMaxInterval is the MaxInterval of a DocumentsEstimatePollStrategy
+	// when it is not set.
+	defaultDocumentsEstimateMaxInterval = 5 * time.Second
+)
+
+// PollStrategy decides how long to wait before polling a task again while waiting for it to be
+// processed. It is installed with WithPollStrategy. Implementations must be safe for concurrent
+// use.
+type PollStrategy interface {
+	// NextInterval returns the delay before the next poll of task, attempt is the number of
+	// polls made so far.
+	NextInterval(attempt int, task *Task) time.Duration
+}
+
+// ConstantPollStrategy polls a task at a fixed interval.
+type ConstantPollStrategy struct {
+	Interval time.Duration
+}
+
+// NextInterval implements PollStrategy.
+func (s ConstantPollStrategy) NextInterval(int, *Task) time.Duration {
+	return s.Interval
+}
+
+// ExponentialPollStrategy multiplies the interval between two polls by Multiplier after every
+// poll, starting from InitialInterval and without exceeding MaxInterval. It suits tasks whose
+// duration is unknown, short tasks are seen done quickly while long ones are polled seldom.
+type ExponentialPollStrategy struct {
+	InitialInterval time.Duration
+
+	// MaxInterval is 2 seconds when not set.
+	MaxInterval time.Duration
+
+	// Multiplier is 2 when not set.
+	Multiplier float64
+}
+
+// DefaultExponentialPollStrategy starts polling every 50ms and slows down up to every 2 seconds.
+func DefaultExponentialPollStrategy() *ExponentialPollStrategy {
+	return &ExponentialPollStrategy{
+		InitialInterval: 50 * time.Millisecond,
+		MaxInterval:     defaultExponentialMaxInterval,
+		Multiplier:      2,
+	}
+}
+
+// NextInterval implements PollStrategy.
+func (s *ExponentialPollStrategy) NextInterval(attempt int, _ *Task) time.Duration {
+	multiplier := s.Multiplier
+	if multiplier <= 1 {
+		multiplier = 2
+	}
+	maxInterval := s.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultExponentialMaxInterval
+	}
+	interval := float64(s.InitialInterval) * math.Pow(multiplier, float64(attempt-1))
+	if interval > float64(maxInterval) {
+		return maxInterval
+	}
+	return time.Duration(interval)
+}
+
+// DocumentsEstimatePollStrategy estimates when a task will be done from the number of documents
+// it received, PerDocument being the time it takes to index one of them. The task is polled at
+// the estimated end, within MinInterval and MaxInterval. Tasks without documents are polled
+// every MinInterval.
+type DocumentsEstimatePollStrategy struct {
+	PerDocument time.Duration
+	MinInterval time.Duration
+
+	// MaxInterval is 5 seconds when not set.
+	MaxInterval time.Duration
+}
+
+// DefaultDocumentsEstimatePollStrategy estimates that 10,000 documents are indexed per second,
+// polling between every 50ms and every 5 seconds.
+func DefaultDocumentsEstimatePollStrategy() *DocumentsEstimatePollStrategy {
+	return &DocumentsEstimatePollStrategy{
+		PerDocument: 100 * time.Microsecond,
+		MinInterval: 50 * time.Millisecond,
+		MaxInterval: defaultDocumentsEstimateMaxInterval,
+	}
+}
+
+// NextInterval implements PollStrategy.
+func (s *DocumentsEstimatePollStrategy) NextInterval(_ int, task *Task) time.Duration {
+	if task == nil || task.Details.ReceivedDocuments == 0 {
+		return s.MinInterval
+	}
+
+	remaining := time.Duration(task.Details.ReceivedDocuments) * s.PerDocument
+	if task.Status == TaskStatusProcessing && !task.StartedAt.IsZero() {
+		remaining -= time.Since(task.StartedAt)
+	}
+
+	maxInterval := s.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultDocumentsEstimateMaxInterval
+	}
+	if remaining < s.MinInterval {
+		return s.MinInterval
+	}
+	if remaining > maxInterval {
+		return maxInterval
+	}
+	return remaining
+}
+
+// pollStrategy returns the strategy polling a task, interval takes precedence over the strategy
+// of the client when it is set.
+func (c *client) pollStrategy(interval time.Duration) PollStrategy {
+	if interval > 0 {
+		return ConstantPollStrategy{Interval: interval}
+	}
+	if c.poll != nil {
+		return c.poll
+	}
+	return ConstantPollStrategy{Interval: defaultPollInterval}
+}
+
+// nextPollInterval returns the delay before the next poll, never polling in a busy loop.
+func nextPollInterval(strategy PollStrategy, attempt int, task *Task) time.Duration {
+	if interval := strategy.NextInterval(attempt, task); interval > 0 {
+		return interval
+	}
+	return defaultPollInterval
+}
@@ -0,0 +1,126 @@
+package meilisearch
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExponentialPollStrategy(t *testing.T) {
+	strategy := &ExponentialPollStrategy{
+		InitialInterval: 10 * time.Millisecond,
+		MaxInterval:     50 * time.Millisecond,
+	}
+
+	var intervals []time.Duration
+	for attempt := 1; attempt <= 5; attempt++ {
+		intervals = append(intervals, strategy.NextInterval(attempt, nil))
+	}
+	require.Equal(t, []time.Duration{
+		10 * time.Millisecond,
+		20 * time.Millisecond,
+		40 * time.Millisecond,
+		50 * time.Millisecond,
+		50 * time.Millisecond,
+	}, intervals)
+
+	// the interval keeps growing up to the default cap when MaxInterval is not set
+	strategy = &ExponentialPollStrategy{InitialInterval: 500 * time.Millisecond}
+	require.Equal(t, time.Second, strategy.NextInterval(2, nil))
+	require.Equal(t, 2*time.Second, strategy.NextInterval(3, nil))
+	require.Equal(t, 2*time.Second, strategy.NextInterval(30, nil))
+}
+
+func TestDocumentsEstimatePollStrategy(t *testing.T) {
+	strategy := DefaultDocumentsEstimatePollStrategy()
+
+	tests := []struct {
+		name string
+		task *Task
+		want time.Duration
+	}{
+		{
+			name: "No task",
+			want: 50 * time.Millisecond,
+		},
+		{
+			name: "No documents",
+			task: &Task{Status: TaskStatusEnqueued},
+			want: 50 * time.Millisecond,
+		},
+		{
+			name: "Estimated",
+			task: &Task{Status: TaskStatusEnqueued, Details: Details{ReceivedDocuments: 10000}},
+			want: time.Second,
+		},
+		{
+			name: "Capped",
+			task: &Task{Status: TaskStatusEnqueued, Details: Details{ReceivedDocuments: 1000000}},
+			want: 5 * time.Second,
+		},
+		{
+			name: "Already processing",
+			task: &Task{
+				Status:    TaskStatusProcessing,
+				StartedAt: time.Now().Add(-time.Hour),
+				Details:   Details{ReceivedDocuments: 10000},
+			},
+			want: 50 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, strategy.NextInterval(1, tt.task))
+		})
+	}
+
+	// the default cap applies when MaxInterval is not set
+	strategy = &DocumentsEstimatePollStrategy{PerDocument: time.Millisecond}
+	require.Equal(t, 5*time.Second, strategy.NextInterval(1, &Task{Details: Details{ReceivedDocuments: 10000}}))
+}
+
+type recordingPollStrategy struct {
+	attempts []int
+}
+
+func (s *recordingPollStrategy) NextInterval(attempt int, _ *Task) time.Duration {
+	s.attempts = append(s.attempts, attempt)
+	return time.Millisecond
+}
+
+func TestWithPollStrategy(t *testing.T) {
+	var polls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := TaskStatusProcessing
+		if atomic.AddInt32(&polls, 1) == 3 {
+			status = TaskStatusSucceeded
+		}
+		_, _ = fmt.Fprintf(w, `{"uid":1,"status":%q,"type":"documentAdditionOrUpdate"}`, status)
+	}))
+	defer ts.Close()
+
+	strategy := &recordingPollStrategy{}
+	task, err := New(ts.URL, WithPollStrategy(strategy)).WaitForTask(1, 0)
+	require.NoError(t, err)
+	require.Equal(t, TaskStatusSucceeded, task.Status)
+	require.Equal(t, []int{1, 2}, strategy.attempts)
+
+	atomic.StoreInt32(&polls, 0)
+	strategy.attempts = nil
+	_, err = New(ts.URL, WithPollStrategy(strategy)).WaitForTask(1, time.Millisecond)
+	require.NoError(t, err)
+	require.Empty(t, strategy.attempts, "an interval takes precedence over the strategy")
+
+	tasksServer := httptest.NewServer(&tasksTestServer{polls: map[int64]int{1: 2}})
+	defer tasksServer.Close()
+
+	_, err = New(tasksServer.URL, WithPollStrategy(strategy)).WaitForTasks([]int64{1}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, strategy.attempts)
+}
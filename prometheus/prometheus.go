@@ -0,0 +1,197 @@
+// Package prometheus exposes the metrics of the Meilisearch client as a prometheus.Collector.
+//
+//	Example:
+//
+//	collector := prometheus.NewCollector()
+//	registry.MustRegister(collector)
+//
+//	meili := meilisearch.New("http://localhost:7700",
+//		meilisearch.WithAPIKey("foobar"),
+//		meilisearch.WithMetricsRecorder(collector),
+//	)
+package prometheus
+
+import (
+	"strconv"
+
+	"github.com/meilisearch/meilisearch-go"
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+const defaultNamespace = "meilisearch_client"
+
+type config struct {
+	namespace       string
+	constLabels     prom.Labels
+	durationBuckets []float64
+	taskWaitBuckets []float64
+}
+
+// Option configures a Collector.
+type Option func(*config)
+
+// WithNamespace set the prefix of the metric names, meilisearch_client by default.
+func WithNamespace(namespace string) Option {
+	return func(cfg *config) {
+		cfg.namespace = namespace
+	}
+}
+
+// WithConstLabels add labels with a fixed value to every metric, e.g. to identify the service.
+func WithConstLabels(labels prom.Labels) Option {
+	return func(cfg *config) {
+		cfg.constLabels = labels
+	}
+}
+
+// WithDurationBuckets set the buckets, in seconds, of the request duration histogram.
+func WithDurationBuckets(buckets []float64) Option {
+	return func(cfg *config) {
+		cfg.durationBuckets = buckets
+	}
+}
+
+// WithTaskWaitBuckets set the buckets, in seconds, of the task wait duration histogram.
+func WithTaskWaitBuckets(buckets []float64) Option {
+	return func(cfg *config) {
+		cfg.taskWaitBuckets = buckets
+	}
+}
+
+// Collector is a prometheus.Collector and a meilisearch.MetricsRecorder, install it on a client
+// with meilisearch.WithMetricsRecorder.
+type Collector struct {
+	requests      *prom.CounterVec
+	duration      *prom.HistogramVec
+	errors        *prom.CounterVec
+	bytesSent     *prom.CounterVec
+	bytesReceived *prom.CounterVec
+	taskWait      *prom.HistogramVec
+}
+
+var (
+	_ prom.Collector              = (*Collector)(nil)
+	_ meilisearch.MetricsRecorder = (*Collector)(nil)
+)
+
+// NewCollector creates a Collector, it must be registered in a prometheus.Registerer.
+func NewCollector(options ...Option) *Collector {
+	cfg := &config{
+		namespace:       defaultNamespace,
+		durationBuckets: prom.DefBuckets,
+		taskWaitBuckets: prom.ExponentialBuckets(0.05, 2, 12),
+	}
+	for _, opt := range options {
+		opt(cfg)
+	}
+
+	return &Collector{
+		requests: prom.NewCounterVec(prom.CounterOpts{
+			Namespace:   cfg.namespace,
+			Name:        "requests_total",
+			Help:        "Number of requests sent to Meilisearch.",
+			ConstLabels: cfg.constLabels,
+		}, []string{"function", "method", "status_code"}),
+		duration: prom.NewHistogramVec(prom.HistogramOpts{
+			Namespace:   cfg.namespace,
+			Name:        "request_duration_seconds",
+			Help:        "Duration of the requests sent to Meilisearch, retries included.",
+			ConstLabels: cfg.constLabels,
+			Buckets:     cfg.durationBuckets,
+		}, []string{"function"}),
+		errors: prom.NewCounterVec(prom.CounterOpts{
+			Namespace:   cfg.namespace,
+			Name:        "errors_total",
+			Help:        "Number of requests to Meilisearch which returned an error.",
+			ConstLabels: cfg.constLabels,
+		}, []string{"function", "err_code", "api_error_code"}),
+		bytesSent: prom.NewCounterVec(prom.CounterOpts{
+			Namespace:   cfg.namespace,
+			Name:        "request_bytes_total",
+			Help:        "Number of bytes sent to Meilisearch in request bodies.",
+			ConstLabels: cfg.constLabels,
+		}, []string{"function"}),
+		bytesReceived: prom.NewCounterVec(prom.CounterOpts{
+			Namespace:   cfg.namespace,
+			Name:        "response_bytes_total",
+			Help:        "Number of bytes received from Meilisearch in response bodies.",
+			ConstLabels: cfg.constLabels,
+		}, []string{"function"}),
+		taskWait: prom.NewHistogramVec(prom.HistogramOpts{
+			Namespace:   cfg.namespace,
+			Name:        "task_wait_duration_seconds",
+			Help:        "Duration of the waits for a task to be processed.",
+			ConstLabels: cfg.constLabels,
+			Buckets:     cfg.taskWaitBuckets,
+		}, []string{"status"}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prom.Desc) {
+	c.requests.Describe(ch)
+	c.duration.Describe(ch)
+	c.errors.Describe(ch)
+	c.bytesSent.Describe(ch)
+	c.bytesReceived.Describe(ch)
+	c.taskWait.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prom.Metric) {
+	c.requests.Collect(ch)
+	c.duration.Collect(ch)
+	c.errors.Collect(ch)
+	c.bytesSent.Collect(ch)
+	c.bytesReceived.Collect(ch)
+	c.taskWait.Collect(ch)
+}
+
+// ObserveRequest implements meilisearch.MetricsRecorder.
+func (c *Collector) ObserveRequest(metrics meilisearch.RequestMetrics) {
+	statusCode := ""
+	if metrics.StatusCode != 0 {
+		statusCode = strconv.Itoa(metrics.StatusCode)
+	}
+
+	c.requests.WithLabelValues(metrics.Function, metrics.Method, statusCode).Inc()
+	c.duration.WithLabelValues(metrics.Function).Observe(metrics.Duration.Seconds())
+	c.bytesSent.WithLabelValues(metrics.Function).Add(float64(metrics.BytesSent))
+	c.bytesReceived.WithLabelValues(metrics.Function).Add(float64(metrics.BytesReceived))
+
+	if metrics.Err != nil {
+		c.errors.WithLabelValues(metrics.Function, errCodeLabel(metrics.ErrCode), string(metrics.APIErrorCode)).Inc()
+	}
+}
+
+// ObserveTaskWait implements meilisearch.MetricsRecorder.
+func (c *Collector) ObserveTaskWait(metrics meilisearch.TaskWaitMetrics) {
+	status := string(metrics.Status)
+	if metrics.Err != nil {
+		status = "error"
+	}
+	c.taskWait.WithLabelValues(status).Observe(metrics.Duration.Seconds())
+}
+
+func errCodeLabel(code meilisearch.ErrCode) string {
+	switch code {
+	case meilisearch.ErrCodeMarshalRequest:
+		return "marshal_request"
+	case meilisearch.ErrCodeResponseUnmarshalBody:
+		return "response_unmarshal_body"
+	case meilisearch.MeilisearchApiError:
+		return "api_error"
+	case meilisearch.MeilisearchApiErrorWithoutMessage:
+		return "api_error_without_message"
+	case meilisearch.MeilisearchTimeoutError:
+		return "timeout"
+	case meilisearch.MeilisearchCommunicationError:
+		return "communication"
+	case meilisearch.MeilisearchTaskError:
+		return "task"
+	case meilisearch.ErrCodeTaskNotFound:
+		return "task_not_found"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,63 @@
+package prometheus
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollector(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/indexes/movies/search":
+			_, _ = w.Write([]byte(`{"hits":[],"processingTimeMs":1,"query":""}`))
+		case "/tasks/1":
+			_, _ = w.Write([]byte(`{"uid":1,"status":"failed"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index not found","code":"index_not_found"}`))
+		}
+	}))
+	defer ts.Close()
+
+	collector := NewCollector(WithConstLabels(prom.Labels{"service": "catalog"}))
+	registry := prom.NewPedanticRegistry()
+	require.NoError(t, registry.Register(collector))
+
+	meili := meilisearch.New(ts.URL, meilisearch.WithMetricsRecorder(collector))
+	_, err := meili.Index("movies").Search("", &meilisearch.SearchRequest{})
+	require.NoError(t, err)
+	_, err = meili.GetIndex("unknown")
+	require.Error(t, err)
+	_, err = meili.WaitForTask(1, 0)
+	require.NoError(t, err)
+
+	expected := `
+# HELP meilisearch_client_requests_total Number of requests sent to Meilisearch.
+# TYPE meilisearch_client_requests_total counter
+meilisearch_client_requests_total{function="FetchInfo",method="GET",service="catalog",status_code="404"} 1
+meilisearch_client_requests_total{function="GetTask",method="GET",service="catalog",status_code="200"} 1
+meilisearch_client_requests_total{function="Search",method="POST",service="catalog",status_code="200"} 1
+# HELP meilisearch_client_errors_total Number of requests to Meilisearch which returned an error.
+# TYPE meilisearch_client_errors_total counter
+meilisearch_client_errors_total{api_error_code="index_not_found",err_code="api_error",function="FetchInfo",service="catalog"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"meilisearch_client_requests_total", "meilisearch_client_errors_total"))
+
+	require.Equal(t, 1, testutil.CollectAndCount(collector, "meilisearch_client_task_wait_duration_seconds"))
+	require.Equal(t, float64(len(`{"hits":[],"processingTimeMs":1,"query":""}`)),
+		testutil.ToFloat64(collector.bytesReceived.WithLabelValues("Search")))
+}
+
+func TestErrCodeLabel(t *testing.T) {
+	require.Equal(t, "task_not_found", errCodeLabel(meilisearch.ErrCodeTaskNotFound))
+	require.Equal(t, "timeout", errCodeLabel(meilisearch.MeilisearchTimeoutError))
+	require.Equal(t, "unknown", errCodeLabel(meilisearch.ErrCodeUnknown))
+}
@@ -0,0 +1,214 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	redacted  = "[REDACTED]"
+	excluded  = "[EXCLUDED]"
+	truncated = "... (truncated)"
+)
+
+const (
+	// defaultErrorBodyLimit is the number of bytes of a body kept in an *Error by default.
+	defaultErrorBodyLimit = 64 << 10
+
+	// errorMessageBodyLimit is the number of bytes of a body written in the message of an *Error.
+	errorMessageBodyLimit = 512
+)
+
+// defaultRedactedFields are the JSON fields holding an API key, such as the key of a Key object
+// or the apiKey of an embedder.
+var defaultRedactedFields = []string{"key", "apiKey"}
+
+var (
+	secretFields = newRedactedFields(defaultRedactedFields)
+
+	// keysEndpointPattern matches the key or uid given in the path of the /keys routes.
+	keysEndpointPattern = regexp.MustCompile(`^(/keys/)[^/?]+`)
+)
+
+// redactedFields are the names of the JSON fields whose values are hidden.
+type redactedFields map[string]bool
+
+func newRedactedFields(fields []string) redactedFields {
+	names := make(redactedFields, len(fields))
+	for _, field := range fields {
+		names[field] = true
+	}
+	return names
+}
+
+// redact hides the whole values of the fields named f in s, strings, numbers, objects or arrays
+// alike. s may be JSON cut by a truncation, a value running to its end is hidden up to it.
+func (f redactedFields) redact(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if s[i] != '"' {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+
+		end := skipJSONString(s, i)
+		b.WriteString(s[i:end])
+		colon := skipJSONSpaces(s, end)
+		if colon == len(s) || s[colon] != ':' || !f[unquoteJSONString(s[i:end])] {
+			i = end
+			continue
+		}
+
+		// the string is the name of a redacted field
+		value := skipJSONSpaces(s, colon+1)
+		b.WriteString(s[end:value])
+		if value == len(s) {
+			break
+		}
+		b.WriteString(`"` + redacted + `"`)
+		i = skipJSONValue(s, value)
+	}
+	return b.String()
+}
+
+// skipJSONString returns the offset following the string starting at s[start], len(s) when it is
+// not terminated.
+func skipJSONString(s string, start int) int {
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+func skipJSONSpaces(s string, i int) int {
+	for i < len(s) && strings.IndexByte(" \t\r\n", s[i]) >= 0 {
+		i++
+	}
+	return i
+}
+
+// skipJSONValue returns the offset following the value starting at s[start], matching the
+// brackets of the objects and arrays. len(s) is returned for a value cut by a truncation.
+func skipJSONValue(s string, start int) int {
+	depth := 0
+	for i := start; i < len(s); {
+		switch s[i] {
+		case '"':
+			i = skipJSONString(s, i)
+			if depth == 0 {
+				return i
+			}
+			continue
+		case '{', '[':
+			depth++
+		case '}', ']':
+			if depth == 0 {
+				return i
+			}
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		case ',', ' ', '\t', '\r', '\n':
+			if depth == 0 {
+				return i
+			}
+		}
+		i++
+	}
+	return len(s)
+}
+
+// unquoteJSONString returns the content of the quoted string s, s itself when it cannot be
+// unquoted.
+func unquoteJSONString(s string) string {
+	var unquoted string
+	if err := json.Unmarshal([]byte(s), &unquoted); err != nil {
+		return s
+	}
+	return unquoted
+}
+
+// redactSecrets hides the API keys found in a request or response dump.
+func redactSecrets(s string) string {
+	return secretFields.redact(s)
+}
+
+// truncate cuts s to its first limit bytes, a limit <= 0 keeps s whole.
+func truncate(s string, limit int) string {
+	if head, cut := cutString(s, limit); cut {
+		return head + truncated
+	}
+	return s
+}
+
+// cutString returns the first limit bytes of s without splitting a rune, and whether s was cut.
+func cutString(s string, limit int) (string, bool) {
+	if limit <= 0 || len(s) <= limit {
+		return s, false
+	}
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+	return s[:limit], true
+}
+
+// errorBodyPolicy controls how the request and response bodies are kept in an *Error.
+type errorBodyPolicy struct {
+	limit   int
+	exclude bool
+	redact  redactedFields
+}
+
+func newErrorBodyPolicy(limit int, exclude bool, redactedFields []string) errorBodyPolicy {
+	return errorBodyPolicy{
+		limit:   limit,
+		exclude: exclude,
+		redact:  newRedactedFields(append(append([]string(nil), defaultRedactedFields...), redactedFields...)),
+	}
+}
+
+// dump returns body as it is kept in an *Error.
+func (p errorBodyPolicy) dump(body []byte) string {
+	if p.exclude {
+		return excluded
+	}
+	if p.limit > 0 && len(body) > p.limit {
+		// only the bytes kept are converted, the rune cut by the limit being dropped
+		body = body[:p.limit+1]
+	}
+	head, cut := cutString(string(body), p.limit)
+	head = p.redact.redact(head)
+	if cut {
+		return head + truncated
+	}
+	return head
+}
+
+// redactEndpoint hides the API key given in the path of an endpoint.
+func redactEndpoint(endpoint string) string {
+	return keysEndpointPattern.ReplaceAllString(endpoint, "${1}"+redacted)
+}
+
+// redactHeader returns a copy of header where the credentials are hidden.
+func redactHeader(header http.Header) http.Header {
+	redactedHeader := make(http.Header, len(header))
+	for key, values := range header {
+		switch strings.ToLower(key) {
+		case "authorization", "x-meili-api-key":
+			redactedHeader[key] = []string{redacted}
+		default:
+			redactedHeader[key] = values
+		}
+	}
+	return redactedHeader
+}
@@ -0,0 +1,161 @@
+package meilisearch
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	require.Equal(t, `{"uid":"1","key":"[REDACTED]","name":"search"}`,
+		redactSecrets(`{"uid":"1","key":"d0552b41536279a0ad88bd595327b96f01176a60c2243e906c52ac02375f9bc4","name":"search"}`))
+	require.Equal(t, `{"embedders":{"default":{"source":"openAi","apiKey":"[REDACTED]"}}}`,
+		redactSecrets(`{"embedders":{"default":{"source":"openAi","apiKey":"sk-\"quoted\""}}}`))
+	require.Equal(t, "/keys/[REDACTED]", redactEndpoint("/keys/d0552b41"))
+	require.Equal(t, "/indexes/keys/documents", redactEndpoint("/indexes/keys/documents"))
+}
+
+func TestRedactedFields(t *testing.T) {
+	fields := newRedactedFields([]string{"address", "emails", "age", "note"})
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "Object and array",
+			in:   `{"id":1,"address":{"street":"1 Main St","city":{"name":"Paris"}},"emails":["a@b.c",["d@e.f"]],"name":"carol"}`,
+			want: `{"id":1,"address":"[REDACTED]","emails":"[REDACTED]","name":"carol"}`,
+		},
+		{
+			name: "Scalars and spaces",
+			in:   "[{\"age\" : 42 , \"note\":\"a \\\"b\\\" } ]\"}, {\"age\":null}]",
+			want: "[{\"age\" : \"[REDACTED]\" , \"note\":\"[REDACTED]\"}, {\"age\":\"[REDACTED]\"}]",
+		},
+		{
+			name: "Nested in a kept field",
+			in:   `{"user":{"address":["x"],"tags":["address"]},"note":"address"}`,
+			want: `{"user":{"address":"[REDACTED]","tags":["address"]},"note":"[REDACTED]"}`,
+		},
+		{
+			name: "Escaped name",
+			in:   `{"em\u0061ils":["a@b.c"]}`,
+			want: `{"em\u0061ils":"[REDACTED]"}`,
+		},
+		{
+			name: "Truncated object",
+			in:   `{"id":1,"address":{"street":"1 Main St","city":"Par`,
+			want: `{"id":1,"address":"[REDACTED]"`,
+		},
+		{
+			name: "Truncated array",
+			in:   `{"emails":["a@b.c", "d@`,
+			want: `{"emails":"[REDACTED]"`,
+		},
+		{
+			name: "Truncated after the name",
+			in:   `{"emails": `,
+			want: `{"emails": `,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, fields.redact(tt.in))
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	require.Equal(t, "abc", truncate("abc", 0))
+	require.Equal(t, "abc", truncate("abc", 3))
+	require.Equal(t, "ab"+truncated, truncate("abc", 2))
+	require.Equal(t, "a"+truncated, truncate("aé", 2))
+}
+
+func TestErrorBodyPolicy(t *testing.T) {
+	documents := `[{"id":1,"email":"carol@example.com","age":42,"address":{"street":"1 Main St"},"note":"` +
+		strings.Repeat("a", 100) + `"}]`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/indexes/movies/documents":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"Invalid document.","code":"invalid_document_fields","type":"invalid_request","link":""}`))
+		default:
+			// The documents cannot be decoded into a search response
+			_, _ = w.Write([]byte(documents))
+		}
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		name         string
+		options      []Option
+		wantResponse string
+	}{
+		{
+			name:         "Default",
+			wantResponse: documents,
+		},
+		{
+			name:         "Limit",
+			options:      []Option{WithErrorBodyLimit(20)},
+			wantResponse: documents[:20] + truncated,
+		},
+		{
+			name:         "Redacted fields",
+			options:      []Option{WithErrorRedactedFields("email", "age")},
+			wantResponse: strings.NewReplacer(`"carol@example.com"`, `"[REDACTED]"`, `42`, `"[REDACTED]"`).Replace(documents),
+		},
+		{
+			name:         "Redacted field cut by the limit",
+			options:      []Option{WithErrorRedactedFields("note"), WithErrorBodyLimit(len(documents) - 10)},
+			wantResponse: documents[:strings.Index(documents, `"note":`)+len(`"note":`)] + `"[REDACTED]"` + truncated,
+		},
+		{
+			name:         "Redacted object cut by the limit",
+			options:      []Option{WithErrorRedactedFields("address"), WithErrorBodyLimit(strings.Index(documents, "Main"))},
+			wantResponse: documents[:strings.Index(documents, `"address":`)+len(`"address":`)] + `"[REDACTED]"` + truncated,
+		},
+		{
+			name:         "Excluded bodies",
+			options:      []Option{WithoutErrorBodies()},
+			wantResponse: excluded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idx := New(ts.URL, tt.options...).Index("movies")
+
+			_, err := idx.Search("", &SearchRequest{})
+			var meiliErr *Error
+			require.ErrorAs(t, err, &meiliErr)
+			require.Equal(t, ErrCodeResponseUnmarshalBody, meiliErr.ErrCode)
+			require.Equal(t, tt.wantResponse, meiliErr.ResponseToString)
+
+			_, err = idx.AddDocuments([]map[string]interface{}{{"id": 1}})
+			require.ErrorAs(t, err, &meiliErr)
+			require.Equal(t, APIErrorCodeInvalidDocumentFields, meiliErr.MeilisearchApiError.Code)
+			if tt.wantResponse == excluded {
+				require.Equal(t, excluded, meiliErr.ResponseToString)
+			}
+		})
+	}
+}
+
+func TestError_BoundedMessage(t *testing.T) {
+	err := (&Error{
+		Endpoint:         "/indexes/movies/search",
+		Method:           http.MethodPost,
+		Function:         "Search",
+		ResponseToString: strings.Repeat("a", 10*errorMessageBodyLimit),
+	}).WithErrCode(ErrCodeResponseUnmarshalBody)
+
+	require.Contains(t, err.Error(), strings.Repeat("a", errorMessageBodyLimit)+truncated+"'")
+	require.NotContains(t, err.Error(), strings.Repeat("a", errorMessageBodyLimit+1))
+}
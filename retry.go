@@ -0,0 +1,134 @@
+package meilisearch
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy describes how the client retries requests that failed because of a
+// transient error: a connection failure or one of the RetryOnStatus status codes.
+//
+// Only idempotent requests are retried (GET, HEAD, PUT and DELETE). Document additions and
+// updates are only retried when their context carries an idempotency guard, see MarkIdempotent.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, the first one included.
+	MaxAttempts int
+
+	// InitialBackoff is the base delay before the first retry, it is doubled after each attempt.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between two attempts, including the one asked by a Retry-After
+	// header of the server.
+	MaxBackoff time.Duration
+
+	// RetryOnStatus is the list of HTTP status codes considered as transient.
+	RetryOnStatus []int
+}
+
+// DefaultRetryPolicy returns a RetryPolicy making at most 3 attempts on connection failures,
+// 429, 502, 503 and 504 responses.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		RetryOnStatus: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+type idempotencyGuardKey struct{}
+
+// MarkIdempotent returns a copy of ctx carrying an idempotency guard. Document additions and
+// updates sent with this context are retried according to the client RetryPolicy, the caller
+// guarantees that sending the same documents twice is harmless.
+func MarkIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotencyGuardKey{}, true)
+}
+
+func hasIdempotencyGuard(ctx context.Context) bool {
+	guard, _ := ctx.Value(idempotencyGuardKey{}).(bool)
+	return guard
+}
+
+func (p *RetryPolicy) retryableStatus(statusCode int) bool {
+	for _, code := range p.RetryOnStatus {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay to wait before the next attempt, attempt being the number of
+// attempts already made. The delay grows exponentially with an equal jitter and a Retry-After
+// header of resp takes precedence over it, both capped by MaxBackoff.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return wait
+		}
+	}
+
+	wait := p.InitialBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	half := int64(wait / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// parseRetryAfter reads a Retry-After header value which is either a number of seconds or an
+// HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
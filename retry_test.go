@@ -0,0 +1,213 @@
+package meilisearch
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteRequest_RetryPolicy(t *testing.T) {
+	var attempts int32
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid":1}`))
+	}))
+	defer ts.Close()
+
+	policy := &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		RetryOnStatus:  []int{http.StatusServiceUnavailable},
+	}
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		internalReq  *internalRequest
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{
+			name: "Retried DELETE request",
+			ctx:  context.Background(),
+			internalReq: &internalRequest{
+				endpoint:            "/indexes/movies",
+				method:              http.MethodDelete,
+				withResponse:        &TaskInfo{},
+				acceptedStatusCodes: []int{http.StatusAccepted},
+			},
+			wantAttempts: 3,
+		},
+		{
+			name: "Not retried POST request",
+			ctx:  context.Background(),
+			internalReq: &internalRequest{
+				endpoint:            "/indexes",
+				method:              http.MethodPost,
+				contentType:         contentTypeJSON,
+				withRequest:         map[string]string{"uid": "movies"},
+				withResponse:        &TaskInfo{},
+				acceptedStatusCodes: []int{http.StatusAccepted},
+			},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name: "Not retried document addition without guard",
+			ctx:  context.Background(),
+			internalReq: &internalRequest{
+				endpoint:                "/indexes/movies/documents",
+				method:                  http.MethodPost,
+				contentType:             contentTypeJSON,
+				withRequest:             []byte(`[{"id":1}]`),
+				withResponse:            &TaskInfo{},
+				acceptedStatusCodes:     []int{http.StatusAccepted},
+				requireIdempotencyGuard: true,
+			},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name: "Retried document addition with guard",
+			ctx:  MarkIdempotent(context.Background()),
+			internalReq: &internalRequest{
+				endpoint:                "/indexes/movies/documents",
+				method:                  http.MethodPost,
+				contentType:             contentTypeJSON,
+				withRequest:             []byte(`[{"id":1}]`),
+				withResponse:            &TaskInfo{},
+				acceptedStatusCodes:     []int{http.StatusAccepted},
+				requireIdempotencyGuard: true,
+			},
+			wantAttempts: 3,
+		},
+		{
+			name: "Retried seekable reader",
+			ctx:  MarkIdempotent(context.Background()),
+			internalReq: &internalRequest{
+				endpoint:                "/indexes/movies/documents",
+				method:                  http.MethodPost,
+				contentType:             contentTypeNDJSON,
+				withRequest:             strings.NewReader(`{"id":1}`),
+				withResponse:            &TaskInfo{},
+				acceptedStatusCodes:     []int{http.StatusAccepted},
+				requireIdempotencyGuard: true,
+			},
+			wantAttempts: 3,
+		},
+		{
+			name: "Not retried non seekable reader",
+			ctx:  MarkIdempotent(context.Background()),
+			internalReq: &internalRequest{
+				endpoint:                "/indexes/movies/documents",
+				method:                  http.MethodPost,
+				contentType:             contentTypeNDJSON,
+				withRequest:             io.MultiReader(strings.NewReader(`{"id":1}`)),
+				withResponse:            &TaskInfo{},
+				acceptedStatusCodes:     []int{http.StatusAccepted},
+				requireIdempotencyGuard: true,
+			},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			atomic.StoreInt32(&attempts, 0)
+			bodies = nil
+
+			c := newClient(&http.Client{}, ts.URL, "")
+			c.retryPolicy = policy
+
+			err := c.executeRequest(tt.ctx, tt.internalReq)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantAttempts, atomic.LoadInt32(&attempts))
+			for _, body := range bodies {
+				require.Equal(t, bodies[0], body)
+			}
+		})
+	}
+}
+
+func TestExecuteRequest_RetryCommunicationError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	host := ts.URL
+	ts.Close()
+
+	c := newClient(&http.Client{}, host, "")
+	c.retryPolicy = &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+
+	err := c.executeRequest(context.Background(), &internalRequest{
+		endpoint:            "/health",
+		method:              http.MethodGet,
+		withResponse:        &Health{},
+		acceptedStatusCodes: []int{http.StatusOK},
+	})
+	require.Error(t, err)
+	require.Equal(t, MeilisearchCommunicationError, err.(*Error).ErrCode)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     300 * time.Millisecond,
+	}
+
+	for attempt := 1; attempt <= 5; attempt++ {
+		wait := policy.backoff(attempt, nil)
+		require.LessOrEqual(t, int64(wait), int64(policy.MaxBackoff))
+		require.GreaterOrEqual(t, int64(wait), int64(policy.InitialBackoff/2))
+	}
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "2")
+	require.Equal(t, policy.MaxBackoff, policy.backoff(1, resp))
+
+	// a long Retry-After cannot stall the call beyond MaxBackoff
+	resp.Header.Set("Retry-After", "86400")
+	require.Equal(t, policy.MaxBackoff, policy.backoff(1, resp))
+
+	policy.MaxBackoff = 5 * time.Second
+	resp.Header.Set("Retry-After", "2")
+	require.Equal(t, 2*time.Second, policy.backoff(1, resp))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value  string
+		wantOk bool
+		want   time.Duration
+	}{
+		{value: "", wantOk: false},
+		{value: "3", wantOk: true, want: 3 * time.Second},
+		{value: "-1", wantOk: false},
+		{value: "soon", wantOk: false},
+		{value: "Mon, 02 Jan 2006 15:04:05 GMT", wantOk: true, want: 0},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value)
+		require.Equal(t, tt.wantOk, ok, tt.value)
+		require.Equal(t, tt.want, got, tt.value)
+	}
+}
@@ -0,0 +1,153 @@
+package meilisearch
+
+import "context"
+
+// defaultSearchHitsPerPage is the number of hits fetched per page by a SearchIterator, the
+// default of Meilisearch.
+const defaultSearchHitsPerPage = 20
+
+// SearchIteratorOptions configures a SearchIterator.
+type SearchIteratorOptions struct {
+	// MaxHits stops the iteration once that many hits were walked, without error. All the hits
+	// are walked when it is not set.
+	MaxHits int64
+
+	// MaxTotalHits is the maxTotalHits of the pagination settings of the index. It is fetched
+	// with GetPagination before the first page when it is not set, which needs an API key with
+	// the settings.get permission.
+	MaxTotalHits int64
+}
+
+// SearchIterator walks all the hits of a search, fetching them page after page with the Page
+// and HitsPerPage of the SearchRequest. HitsPerPage is the size of the pages, the Limit of the
+// request or 20 when it is not set, and Page is the first page fetched. The Offset of the
+// request is ignored.
+//
+// Meilisearch never returns more hits than the maxTotalHits of the pagination settings of the
+// index. Once that many hits were walked, Next returns false and Err a *SearchTruncatedError as
+// the search may have matched more documents. Meilisearch not telling them apart, a search
+// matching exactly maxTotalHits documents is reported as truncated too. Unless it is given by
+// the MaxTotalHits of the options, maxTotalHits is fetched with GetPagination before the first
+// page: an extra request, made once, that needs an API key with the settings.get permission.
+//
+//	it := idx.SearchIterator("carol", &meilisearch.SearchRequest{HitsPerPage: 100}, nil)
+//	for it.Next() {
+//		fmt.Println(it.Hit())
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+type SearchIterator struct {
+	ctx     context.Context
+	index   IndexManager
+	query   string
+	request SearchRequest
+	opts    SearchIteratorOptions
+
+	hits              []interface{}
+	hit               interface{}
+	walked            int64
+	last              bool
+	truncated         bool
+	paginationFetched bool
+	err               error
+}
+
+func newSearchIterator(ctx context.Context, index IndexManager, query string, request *SearchRequest, opts *SearchIteratorOptions) *SearchIterator {
+	it := &SearchIterator{
+		ctx:   ctx,
+		index: index,
+		query: query,
+	}
+	if request == nil {
+		it.err = ErrNoSearchRequest
+		return it
+	}
+	if opts != nil {
+		it.opts = *opts
+	}
+	it.paginationFetched = it.opts.MaxTotalHits > 0
+
+	it.request = *request
+	if it.request.HitsPerPage <= 0 {
+		it.request.HitsPerPage = it.request.Limit
+	}
+	if it.request.HitsPerPage <= 0 {
+		it.request.HitsPerPage = defaultSearchHitsPerPage
+	}
+	if it.request.Page <= 0 {
+		it.request.Page = 1
+	}
+	it.request.Offset = 0
+	it.request.Limit = 0
+	return it
+}
+
+// Next advances to the next hit, fetching the next page when needed. It returns false once all
+// the hits or MaxHits hits were walked, or when an error occurred, which is then returned by Err.
+func (it *SearchIterator) Next() bool {
+	it.hit = nil
+	if it.opts.MaxHits > 0 && it.walked >= it.opts.MaxHits {
+		return false
+	}
+
+	for len(it.hits) == 0 {
+		if it.err != nil {
+			return false
+		}
+		if it.last {
+			if it.truncated {
+				it.err = &SearchTruncatedError{MaxTotalHits: it.opts.MaxTotalHits}
+			}
+			return false
+		}
+		if it.err = it.ctx.Err(); it.err != nil {
+			return false
+		}
+		if it.err = it.fetch(); it.err != nil {
+			return false
+		}
+	}
+
+	it.hit = it.hits[0]
+	it.hits = it.hits[1:]
+	it.walked++
+	return true
+}
+
+// Hit returns the current hit, nil before the first call to Next and once it returned false.
+func (it *SearchIterator) Hit() interface{} {
+	return it.hit
+}
+
+// Err returns the error that stopped the iteration, nil when all the hits were walked.
+func (it *SearchIterator) Err() error {
+	return it.err
+}
+
+// fetch fetches the next page of hits.
+func (it *SearchIterator) fetch() error {
+	if !it.paginationFetched {
+		pagination, err := it.index.GetPaginationWithContext(it.ctx)
+		if err != nil {
+			return err
+		}
+		it.opts.MaxTotalHits = pagination.MaxTotalHits
+		it.paginationFetched = true
+	}
+
+	// the request is copied as searching changes it
+	request := it.request
+	resp, err := it.index.SearchWithContext(it.ctx, it.query, &request)
+	if err != nil {
+		return err
+	}
+
+	it.hits = resp.Hits
+	if it.request.Page >= resp.TotalPages || int64(len(resp.Hits)) < it.request.HitsPerPage {
+		it.last = true
+		it.truncated = it.opts.MaxTotalHits > 0 && resp.TotalHits >= it.opts.MaxTotalHits
+	}
+	it.request.Page++
+	return nil
+}
@@ -0,0 +1,160 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// searchedHitsServer serves the first totalHits documents matched by a search, capped at
+// maxTotalHits as Meilisearch does when it is set, page after page.
+type searchedHitsServer struct {
+	totalHits    int64
+	maxTotalHits int64
+
+	paginations int
+	requests    []SearchRequest
+}
+
+func (s *searchedHitsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/indexes/movies/settings/pagination":
+		s.paginations++
+		_ = json.NewEncoder(w).Encode(Pagination{MaxTotalHits: s.maxTotalHits})
+	case "/indexes/movies/search":
+		var req SearchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.requests = append(s.requests, req)
+
+		total := s.totalHits
+		if s.maxTotalHits > 0 && total > s.maxTotalHits {
+			total = s.maxTotalHits
+		}
+		resp := SearchResponse{
+			Hits:        []interface{}{},
+			Query:       req.Query,
+			TotalHits:   total,
+			HitsPerPage: req.HitsPerPage,
+			Page:        req.Page,
+			TotalPages:  (total + req.HitsPerPage - 1) / req.HitsPerPage,
+		}
+		for id := (req.Page - 1) * req.HitsPerPage; id < req.Page*req.HitsPerPage && id < total; id++ {
+			resp.Hits = append(resp.Hits, map[string]interface{}{"id": float64(id)})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func walkSearchIterator(it *SearchIterator) []float64 {
+	var ids []float64
+	for it.Next() {
+		ids = append(ids, it.Hit().(map[string]interface{})["id"].(float64))
+	}
+	return ids
+}
+
+func TestSearchIterator(t *testing.T) {
+	server := &searchedHitsServer{totalHits: 5, maxTotalHits: 1000}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	request := &SearchRequest{Limit: 2, Offset: 10, Filter: "id > 0"}
+	it := New(ts.URL).Index("movies").SearchIterator("carol", request, nil)
+	require.Nil(t, it.Hit())
+
+	require.Equal(t, []float64{0, 1, 2, 3, 4}, walkSearchIterator(it))
+	require.NoError(t, it.Err())
+	require.Nil(t, it.Hit())
+	require.False(t, it.Next())
+
+	require.Equal(t, 1, server.paginations)
+	require.Len(t, server.requests, 3)
+	for i, req := range server.requests {
+		require.Equal(t, "carol", req.Query)
+		require.Equal(t, "id > 0", req.Filter)
+		require.Equal(t, int64(i+1), req.Page)
+		require.Equal(t, int64(2), req.HitsPerPage)
+		require.Zero(t, req.Limit)
+		require.Zero(t, req.Offset)
+	}
+	// the request of the caller is left untouched
+	require.Equal(t, &SearchRequest{Limit: 2, Offset: 10, Filter: "id > 0"}, request)
+}
+
+func TestSearchIterator_MaxHits(t *testing.T) {
+	server := &searchedHitsServer{totalHits: 50, maxTotalHits: 20}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	// stopping at the cap of the caller is not an error, even below the maxTotalHits
+	it := New(ts.URL).Index("movies").SearchIterator("", &SearchRequest{HitsPerPage: 4, Page: 2}, &SearchIteratorOptions{MaxHits: 5})
+	require.Equal(t, []float64{4, 5, 6, 7, 8}, walkSearchIterator(it))
+	require.NoError(t, it.Err())
+	require.Len(t, server.requests, 2)
+}
+
+func TestSearchIterator_Truncated(t *testing.T) {
+	server := &searchedHitsServer{totalHits: 50, maxTotalHits: 7}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	it := New(ts.URL).Index("movies").SearchIterator("", &SearchRequest{HitsPerPage: 3}, nil)
+	require.Equal(t, []float64{0, 1, 2, 3, 4, 5, 6}, walkSearchIterator(it))
+
+	var truncated *SearchTruncatedError
+	require.ErrorAs(t, it.Err(), &truncated)
+	require.Equal(t, int64(7), truncated.MaxTotalHits)
+	require.EqualError(t, it.Err(), "search results truncated at 7 hits, the maxTotalHits of the pagination settings of the index")
+
+	// the maxTotalHits of the options spares fetching the pagination settings
+	server.paginations = 0
+	it = New(ts.URL).Index("movies").SearchIterator("", &SearchRequest{}, &SearchIteratorOptions{MaxTotalHits: 7})
+	require.Len(t, walkSearchIterator(it), 7)
+	require.ErrorAs(t, it.Err(), &truncated)
+	require.Zero(t, server.paginations)
+}
+
+func TestSearchIterator_NoMaxTotalHits(t *testing.T) {
+	server := &searchedHitsServer{totalHits: 5}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	// the pagination settings are fetched once even when they report no maxTotalHits
+	it := New(ts.URL).Index("movies").SearchIterator("", &SearchRequest{HitsPerPage: 2}, nil)
+	require.Equal(t, []float64{0, 1, 2, 3, 4}, walkSearchIterator(it))
+	require.NoError(t, it.Err())
+	require.Len(t, server.requests, 3)
+	require.Equal(t, 1, server.paginations)
+}
+
+func TestSearchIterator_Errors(t *testing.T) {
+	server := &searchedHitsServer{totalHits: 5, maxTotalHits: 1000}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	it := New(ts.URL).Index("movies").SearchIterator("", nil, nil)
+	require.False(t, it.Next())
+	require.ErrorIs(t, it.Err(), ErrNoSearchRequest)
+
+	it = New(ts.URL).Index("unknown").SearchIterator("", &SearchRequest{}, nil)
+	require.False(t, it.Next())
+	var meiliErr *Error
+	require.ErrorAs(t, it.Err(), &meiliErr)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	it = New(ts.URL).Index("movies").SearchIteratorWithContext(ctx, "", &SearchRequest{}, nil)
+	require.False(t, it.Next())
+	require.ErrorIs(t, it.Err(), context.Canceled)
+	require.Empty(t, server.requests)
+}
@@ -0,0 +1,82 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// TypedSearchResponse is a SearchResponse whose hits are decoded into T. The fields added by
+// Meilisearch to the hits, such as _formatted or _rankingScore, are decoded into HitsMetadata.
+type TypedSearchResponse[T any] struct {
+	Hits []T `json:"hits"`
+
+	// HitsMetadata are the fields added by Meilisearch to each hit, in the order of Hits.
+	HitsMetadata []SearchHitMetadata `json:"-"`
+
+	EstimatedTotalHits int64       `json:"estimatedTotalHits,omitempty"`
+	Offset             int64       `json:"offset,omitempty"`
+	Limit              int64       `json:"limit,omitempty"`
+	ProcessingTimeMs   int64       `json:"processingTimeMs"`
+	Query              string      `json:"query"`
+	FacetDistribution  interface{} `json:"facetDistribution,omitempty"`
+	TotalHits          int64       `json:"totalHits,omitempty"`
+	HitsPerPage        int64       `json:"hitsPerPage,omitempty"`
+	Page               int64       `json:"page,omitempty"`
+	TotalPages         int64       `json:"totalPages,omitempty"`
+	FacetStats         interface{} `json:"facetStats,omitempty"`
+	IndexUID           string      `json:"indexUid,omitempty"`
+}
+
+// typedSearchResponse decodes the fields of a TypedSearchResponse other than the hits metadata.
+type typedSearchResponse[T any] TypedSearchResponse[T]
+
+// searchHitsMetadata decodes the metadata of the hits of a search response.
+type searchHitsMetadata struct {
+	Hits []SearchHitMetadata `json:"hits"`
+}
+
+// UnmarshalJSON decodes every hit both into T and into its SearchHitMetadata.
+func (r *TypedSearchResponse[T]) UnmarshalJSON(data []byte) error {
+	return r.decodeJSON(data, json.Unmarshal)
+}
+
+// decodeJSON decodes data with unmarshal. Every hit is decoded once into T, the metadata of the
+// hits being then decoded on their own, skipping the other fields.
+func (r *TypedSearchResponse[T]) decodeJSON(data []byte, unmarshal JSONUnmarshal) error {
+	if err := unmarshal(data, (*typedSearchResponse[T])(r)); err != nil {
+		return err
+	}
+
+	var metadata searchHitsMetadata
+	if err := unmarshal(data, &metadata); err != nil {
+		return err
+	}
+	r.HitsMetadata = metadata.Hits
+	return nil
+}
+
+// SearchAs searches idx like IndexManager.SearchWithContext, decoding the hits straight into T,
+// such as a struct whose json tags are the attributes of the documents. The response is decoded
+// with the JSON unmarshaler of the client, or with encoding/json when idx is not an index of this
+// package. The response body is read whole in memory before being decoded, twice: once for the
+// hits and the other fields, once for the metadata of the hits.
+func SearchAs[T any](ctx context.Context, idx IndexManager, query string, request *SearchRequest) (*TypedSearchResponse[T], error) {
+	resp := new(TypedSearchResponse[T])
+
+	i, ok := idx.(*index)
+	if !ok {
+		raw, err := idx.SearchRawWithContext(ctx, query, request)
+		if err != nil {
+			return nil, err
+		}
+		if err := resp.UnmarshalJSON(*raw); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	if err := i.search(ctx, "SearchAs", query, request, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,120 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const typedSearchPayload = `{
+	"hits": [
+		{
+			"id": 1,
+			"title": "Carol",
+			"genres": ["Romance", "Drama"],
+			"_formatted": {"id": "1", "title": "<em>Carol</em>", "genres": ["Romance", "Drama"]},
+			"_matchesPosition": {"title": [{"start": 0, "length": 5}]},
+			"_rankingScore": 0.9848,
+			"_rankingScoreDetails": {
+				"words": {"order": 0, "matchingWords": 1, "maxMatchingWords": 1, "score": 1.0},
+				"typo": {"order": 1, "typoCount": 0, "maxTypoCount": 1, "score": 1.0},
+				"exactness": {"order": 2, "matchType": "exactMatch", "score": 1.0}
+			}
+		},
+		{
+			"id": 2,
+			"title": "Caroline",
+			"genres": []
+		}
+	],
+	"query": "carol",
+	"processingTimeMs": 2,
+	"limit": 20,
+	"offset": 0,
+	"estimatedTotalHits": 2
+}`
+
+type typedSearchMovie struct {
+	ID     int64    `json:"id"`
+	Title  string   `json:"title"`
+	Genres []string `json:"genres"`
+}
+
+func TestSearchAs(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = w.Write([]byte(typedSearchPayload))
+	}))
+	defer ts.Close()
+
+	idx := New(ts.URL).Index("movies")
+	indexes := map[string]IndexManager{
+		"Index":        idx,
+		"IndexManager": struct{ IndexManager }{idx},
+	}
+
+	for name, idx := range indexes {
+		t.Run(name, func(t *testing.T) {
+			resp, err := SearchAs[typedSearchMovie](context.Background(), idx, "carol", &SearchRequest{
+				AttributesToHighlight:   []string{"title"},
+				ShowMatchesPosition:     true,
+				ShowRankingScore:        true,
+				ShowRankingScoreDetails: true,
+			})
+			require.NoError(t, err)
+
+			require.Equal(t, []typedSearchMovie{
+				{ID: 1, Title: "Carol", Genres: []string{"Romance", "Drama"}},
+				{ID: 2, Title: "Caroline", Genres: []string{}},
+			}, resp.Hits)
+			require.Equal(t, "carol", resp.Query)
+			require.Equal(t, int64(2), resp.EstimatedTotalHits)
+
+			require.Len(t, resp.HitsMetadata, 2)
+			metadata := resp.HitsMetadata[0]
+			require.Equal(t, "<em>Carol</em>", metadata.Formatted["title"])
+			require.Equal(t, []MatchPosition{{Start: 0, Length: 5}}, metadata.MatchesPosition["title"])
+			require.Equal(t, 0.9848, metadata.RankingScore)
+			require.Equal(t, int64(1), metadata.RankingScoreDetails["typo"].MaxTypoCount)
+			require.Equal(t, "exactMatch", metadata.RankingScoreDetails["exactness"].MatchType)
+			require.Empty(t, resp.HitsMetadata[1])
+		})
+	}
+	require.Equal(t, []string{"/indexes/movies/search", "/indexes/movies/search"}, paths)
+
+	_, err := SearchAs[typedSearchMovie](context.Background(), idx, "carol", nil)
+	require.ErrorIs(t, err, ErrNoSearchRequest)
+
+	_, err = SearchAs[struct{ ID string }](context.Background(), idx, "carol", &SearchRequest{})
+	var meiliErr *Error
+	require.ErrorAs(t, err, &meiliErr)
+	require.Equal(t, ErrCodeResponseUnmarshalBody, meiliErr.ErrCode)
+}
+
+func TestSearchAs_JSONUnmarshaler(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(typedSearchPayload))
+	}))
+	defer ts.Close()
+
+	var unmarshaled []interface{}
+	meili := New(ts.URL, WithJSONUnmarshaler(func(data []byte, v interface{}) error {
+		unmarshaled = append(unmarshaled, v)
+		return json.Unmarshal(data, v)
+	}))
+
+	resp, err := SearchAs[typedSearchMovie](context.Background(), meili.Index("movies"), "carol", &SearchRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Hits, 2)
+	require.Equal(t, 0.9848, resp.HitsMetadata[0].RankingScore)
+
+	// the hits are decoded into T by the unmarshaler of the client, and their metadata apart
+	require.Len(t, unmarshaled, 2)
+	require.IsType(t, &typedSearchResponse[typedSearchMovie]{}, unmarshaled[0])
+	require.IsType(t, &searchHitsMetadata{}, unmarshaled[1])
+}
@@ -0,0 +1,194 @@
+package meilisearch
+
+// DocumentAdditionDetails are the details of a task of type TaskTypeDocumentAdditionOrUpdate.
+type DocumentAdditionDetails struct {
+	ReceivedDocuments int64 `json:"receivedDocuments,omitempty"`
+	IndexedDocuments  int64 `json:"indexedDocuments,omitempty"`
+}
+
+// DocumentDeletionDetails are the details of a task of type TaskTypeDocumentDeletion.
+type DocumentDeletionDetails struct {
+	ProvidedIds      int64  `json:"providedIds,omitempty"`
+	DeletedDocuments int64  `json:"deletedDocuments,omitempty"`
+	OriginalFilter   string `json:"originalFilter,omitempty"`
+}
+
+// IndexDetails are the details of a task of type TaskTypeIndexCreation or TaskTypeIndexUpdate.
+type IndexDetails struct {
+	PrimaryKey string `json:"primaryKey,omitempty"`
+}
+
+// IndexDeletionDetails are the details of a task of type TaskTypeIndexDeletion.
+type IndexDeletionDetails struct {
+	DeletedDocuments int64 `json:"deletedDocuments,omitempty"`
+}
+
+// IndexSwapDetails are the details of a task of type TaskTypeIndexSwap.
+type IndexSwapDetails struct {
+	Swaps []SwapIndexesParams `json:"swaps,omitempty"`
+}
+
+// SettingsUpdateDetails are the details of a task of type TaskTypeSettingsUpdate, only the
+// updated settings are set.
+type SettingsUpdateDetails struct {
+	RankingRules         []string            `json:"rankingRules,omitempty"`
+	DistinctAttribute    *string             `json:"distinctAttribute,omitempty"`
+	SearchableAttributes []string            `json:"searchableAttributes,omitempty"`
+	DisplayedAttributes  []string            `json:"displayedAttributes,omitempty"`
+	StopWords            []string            `json:"stopWords,omitempty"`
+	Synonyms             map[string][]string `json:"synonyms,omitempty"`
+	FilterableAttributes []string            `json:"filterableAttributes,omitempty"`
+	SortableAttributes   []string            `json:"sortableAttributes,omitempty"`
+	TypoTolerance        *TypoTolerance      `json:"typoTolerance,omitempty"`
+	Pagination           *Pagination         `json:"pagination,omitempty"`
+	Faceting             *Faceting           `json:"faceting,omitempty"`
+}
+
+// DumpCreationDetails are the details of a task of type TaskTypeDumpCreation.
+type DumpCreationDetails struct {
+	DumpUid string `json:"dumpUid,omitempty"`
+}
+
+// TaskCancelationDetails are the details of a task of type TaskTypeTaskCancelation.
+type TaskCancelationDetails struct {
+	MatchedTasks   int64  `json:"matchedTasks,omitempty"`
+	CanceledTasks  int64  `json:"canceledTasks,omitempty"`
+	OriginalFilter string `json:"originalFilter,omitempty"`
+}
+
+// TaskDeletionDetails are the details of a task of type TaskTypeTaskDeletion.
+type TaskDeletionDetails struct {
+	MatchedTasks   int64  `json:"matchedTasks,omitempty"`
+	DeletedTasks   int64  `json:"deletedTasks,omitempty"`
+	OriginalFilter string `json:"originalFilter,omitempty"`
+}
+
+// DocumentAdditionDetails returns the details of a document addition or update, false is
+// returned when the task is of another type.
+func (t *Task) DocumentAdditionDetails() (*DocumentAdditionDetails, bool) {
+	if t.Type != TaskTypeDocumentAdditionOrUpdate {
+		return nil, false
+	}
+	return &DocumentAdditionDetails{
+		ReceivedDocuments: t.Details.ReceivedDocuments,
+		IndexedDocuments:  t.Details.IndexedDocuments,
+	}, true
+}
+
+// DocumentDeletionDetails returns the details of a document deletion, false is returned when
+// the task is of another type.
+func (t *Task) DocumentDeletionDetails() (*DocumentDeletionDetails, bool) {
+	if t.Type != TaskTypeDocumentDeletion {
+		return nil, false
+	}
+	return &DocumentDeletionDetails{
+		ProvidedIds:      t.Details.ProvidedIds,
+		DeletedDocuments: t.Details.DeletedDocuments,
+		OriginalFilter:   t.Details.OriginalFilter,
+	}, true
+}
+
+// IndexDetails returns the details of an index creation or update, false is returned when the
+// task is of another type.
+func (t *Task) IndexDetails() (*IndexDetails, bool) {
+	if t.Type != TaskTypeIndexCreation && t.Type != TaskTypeIndexUpdate {
+		return nil, false
+	}
+	return &IndexDetails{
+		PrimaryKey: t.Details.PrimaryKey,
+	}, true
+}
+
+// IndexDeletionDetails returns the details of an index deletion, false is returned when the
+// task is of another type.
+func (t *Task) IndexDeletionDetails() (*IndexDeletionDetails, bool) {
+	if t.Type != TaskTypeIndexDeletion {
+		return nil, false
+	}
+	return &IndexDeletionDetails{
+		DeletedDocuments: t.Details.DeletedDocuments,
+	}, true
+}
+
+// IndexSwapDetails returns the details of an index swap, false is returned when the task is of
+// another type.
+func (t *Task) IndexSwapDetails() (*IndexSwapDetails, bool) {
+	if t.Type != TaskTypeIndexSwap {
+		return nil, false
+	}
+	return &IndexSwapDetails{
+		Swaps: t.Details.Swaps,
+	}, true
+}
+
+// SettingsUpdateDetails returns the details of a settings update, false is returned when the
+// task is of another type.
+func (t *Task) SettingsUpdateDetails() (*SettingsUpdateDetails, bool) {
+	if t.Type != TaskTypeSettingsUpdate {
+		return nil, false
+	}
+	return &SettingsUpdateDetails{
+		RankingRules:         t.Details.RankingRules,
+		DistinctAttribute:    t.Details.DistinctAttribute,
+		SearchableAttributes: t.Details.SearchableAttributes,
+		DisplayedAttributes:  t.Details.DisplayedAttributes,
+		StopWords:            t.Details.StopWords,
+		Synonyms:             t.Details.Synonyms,
+		FilterableAttributes: t.Details.FilterableAttributes,
+		SortableAttributes:   t.Details.SortableAttributes,
+		TypoTolerance:        t.Details.TypoTolerance,
+		Pagination:           t.Details.Pagination,
+		Faceting:             t.Details.Faceting,
+	}, true
+}
+
+// DumpCreationDetails returns the details of a dump creation, false is returned when the task
+// is of another type.
+func (t *Task) DumpCreationDetails() (*DumpCreationDetails, bool) {
+	if t.Type != TaskTypeDumpCreation {
+		return nil, false
+	}
+	return &DumpCreationDetails{
+		DumpUid: t.Details.DumpUid,
+	}, true
+}
+
+// TaskCancelationDetails returns the details of a task cancelation, false is returned when the
+// task is of another type.
+func (t *Task) TaskCancelationDetails() (*TaskCancelationDetails, bool) {
+	if t.Type != TaskTypeTaskCancelation {
+		return nil, false
+	}
+	return &TaskCancelationDetails{
+		MatchedTasks:   t.Details.MatchedTasks,
+		CanceledTasks:  t.Details.CanceledTasks,
+		OriginalFilter: t.Details.OriginalFilter,
+	}, true
+}
+
+// TaskDeletionDetails returns the details of a task deletion, false is returned when the task
+// is of another type.
+func (t *Task) TaskDeletionDetails() (*TaskDeletionDetails, bool) {
+	if t.Type != TaskTypeTaskDeletion {
+		return nil, false
+	}
+	return &TaskDeletionDetails{
+		MatchedTasks:   t.Details.MatchedTasks,
+		DeletedTasks:   t.Details.DeletedTasks,
+		OriginalFilter: t.Details.OriginalFilter,
+	}, true
+}
+
+// Err returns the error of a failed task as an *Error with the code MeilisearchTaskError, so
+// that it can be tested with errors.Is like the errors of the requests. The fields describing a
+// request are empty, the error coming from the task and not from a request. Nil is returned when
+// the task did not fail.
+func (t *Task) Err() error {
+	if t.Error.Code == "" && t.Error.Message == "" {
+		return nil
+	}
+
+	return (&Error{
+		MeilisearchApiError: t.Error,
+	}).WithErrCode(MeilisearchTaskError)
+}
@@ -0,0 +1,115 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTask_Details(t *testing.T) {
+	distinct := "title"
+	tests := []struct {
+		name string
+		task Task
+		want interface{}
+		get  func(task *Task) (interface{}, bool)
+	}{
+		{
+			name: "Document addition",
+			task: Task{Type: TaskTypeDocumentAdditionOrUpdate, Details: Details{ReceivedDocuments: 3, IndexedDocuments: 2}},
+			want: &DocumentAdditionDetails{ReceivedDocuments: 3, IndexedDocuments: 2},
+			get:  func(task *Task) (interface{}, bool) { return task.DocumentAdditionDetails() },
+		},
+		{
+			name: "Document deletion",
+			task: Task{Type: TaskTypeDocumentDeletion, Details: Details{DeletedDocuments: 4, OriginalFilter: "genre = horror"}},
+			want: &DocumentDeletionDetails{DeletedDocuments: 4, OriginalFilter: "genre = horror"},
+			get:  func(task *Task) (interface{}, bool) { return task.DocumentDeletionDetails() },
+		},
+		{
+			name: "Index update",
+			task: Task{Type: TaskTypeIndexUpdate, Details: Details{PrimaryKey: "id"}},
+			want: &IndexDetails{PrimaryKey: "id"},
+			get:  func(task *Task) (interface{}, bool) { return task.IndexDetails() },
+		},
+		{
+			name: "Index swap",
+			task: Task{Type: TaskTypeIndexSwap, Details: Details{Swaps: []SwapIndexesParams{{Indexes: []string{"a", "b"}}}}},
+			want: &IndexSwapDetails{Swaps: []SwapIndexesParams{{Indexes: []string{"a", "b"}}}},
+			get:  func(task *Task) (interface{}, bool) { return task.IndexSwapDetails() },
+		},
+		{
+			name: "Settings update",
+			task: Task{Type: TaskTypeSettingsUpdate, Details: Details{DistinctAttribute: &distinct, StopWords: []string{"the"}}},
+			want: &SettingsUpdateDetails{DistinctAttribute: &distinct, StopWords: []string{"the"}},
+			get:  func(task *Task) (interface{}, bool) { return task.SettingsUpdateDetails() },
+		},
+		{
+			name: "Task cancelation",
+			task: Task{Type: TaskTypeTaskCancelation, Details: Details{MatchedTasks: 2, CanceledTasks: 1, OriginalFilter: "?uids=1,2"}},
+			want: &TaskCancelationDetails{MatchedTasks: 2, CanceledTasks: 1, OriginalFilter: "?uids=1,2"},
+			get:  func(task *Task) (interface{}, bool) { return task.TaskCancelationDetails() },
+		},
+		{
+			name: "Dump creation",
+			task: Task{Type: TaskTypeDumpCreation, Details: Details{DumpUid: "20240101-000000000"}},
+			want: &DumpCreationDetails{DumpUid: "20240101-000000000"},
+			get:  func(task *Task) (interface{}, bool) { return task.DumpCreationDetails() },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			details, ok := tt.get(&tt.task)
+			require.True(t, ok)
+			require.Equal(t, tt.want, details)
+
+			// the typed details are encoded like the fields of Details they come from
+			b, err := json.Marshal(details)
+			require.NoError(t, err)
+			want, err := json.Marshal(tt.task.Details)
+			require.NoError(t, err)
+			require.JSONEq(t, string(want), string(b))
+		})
+	}
+
+	task := &Task{Type: TaskTypeSettingsUpdate}
+	details, ok := task.DocumentAdditionDetails()
+	require.False(t, ok)
+	require.Nil(t, details)
+}
+
+func TestTask_Err(t *testing.T) {
+	task := &Task{}
+	require.NoError(t, task.UnmarshalJSON([]byte(`{
+		"uid": 12,
+		"indexUid": "movies",
+		"status": "failed",
+		"type": "documentAdditionOrUpdate",
+		"error": {
+			"message": "Index `+"`movies`"+` not found.",
+			"code": "index_not_found",
+			"type": "invalid_request",
+			"link": "https://docs.meilisearch.com/errors#index_not_found"
+		}
+	}`)))
+
+	err := task.Err()
+	require.ErrorIs(t, err, ErrIndexNotFound)
+	require.True(t, IsNotFound(err))
+
+	var meiliErr *Error
+	require.ErrorAs(t, err, &meiliErr)
+	require.Equal(t, MeilisearchTaskError, meiliErr.ErrCode)
+	require.Empty(t, meiliErr.Endpoint)
+	require.Empty(t, meiliErr.Method)
+	require.Empty(t, meiliErr.Function)
+	require.Equal(t, APIErrorCodeIndexNotFound, meiliErr.MeilisearchApiError.Code)
+	require.True(t, strings.HasPrefix(err.Error(), "task failed, MeilisearchApiError Message: "))
+	require.True(t, strings.HasSuffix(err.Error(), ", Code: index_not_found, Type: invalid_request, "+
+		"Link: https://docs.meilisearch.com/errors#index_not_found"))
+
+	require.NoError(t, (&Task{Status: TaskStatusSucceeded}).Err())
+}
@@ -0,0 +1,166 @@
+package meilisearch
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxTasksQueryUIDs is the number of task uids sent in a single tasks query, so that the query
+// string stays within the limits of the servers and proxies.
+const maxTasksQueryUIDs = 500
+
+// WaitForTasksOptions configures WaitForTasks.
+type WaitForTasksOptions struct {
+	// Interval between two polls of the pending tasks. When it is not set, the pending tasks are
+	// polled according to the PollStrategy, the one of the client by default.
+	Interval time.Duration
+
+	// PollStrategy decides the interval between two polls from the state of the pending tasks,
+	// the shortest interval of all the pending tasks is used.
+	PollStrategy PollStrategy
+
+	// FailFast returns a *TaskFailedError as soon as a task failed or was canceled, without
+	// waiting for the other tasks.
+	FailFast bool
+
+	// OnTaskDone is called once for every task, as soon as it is processed.
+	OnTaskDone func(task *Task)
+}
+
+// waitForTasks waits for all the tasks identified by uids to be processed, polling the pending
+// ones with a single tasks query. The tasks are returned in the order of uids. An *Error matching
+// ErrTaskNotFound is returned as soon as a task is missing from the results, such as a deleted
+// task, as it would never be processed.
+func waitForTasks(ctx context.Context, cli *client, uids []int64, opts *WaitForTasksOptions) ([]Task, error) {
+	if opts == nil {
+		opts = &WaitForTasksOptions{}
+	}
+	strategy := opts.PollStrategy
+	if strategy == nil || opts.Interval > 0 {
+		strategy = cli.pollStrategy(opts.Interval)
+	}
+
+	start := time.Now()
+	meili := &meilisearch{client: cli}
+	done := make(map[int64]*Task, len(uids))
+	pending := uniqueUIDs(uids)
+
+	for attempt := 1; ; attempt++ {
+		interval := time.Duration(0)
+		returned := make(map[int64]bool, len(pending))
+		for offset := 0; offset < len(pending); offset += maxTasksQueryUIDs {
+			end := offset + maxTasksQueryUIDs
+			if end > len(pending) {
+				end = len(pending)
+			}
+			chunk := pending[offset:end]
+
+			res, err := meili.GetTasksWithContext(ctx, &TasksQuery{
+				UIDS:  chunk,
+				Limit: int64(len(chunk)),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range res.Results {
+				task := &res.Results[i]
+				returned[task.UID] = true
+				if _, ok := done[task.UID]; ok {
+					continue
+				}
+				if task.Status == TaskStatusEnqueued || task.Status == TaskStatusProcessing {
+					next := nextPollInterval(strategy, attempt, task)
+					if interval == 0 || next < interval {
+						interval = next
+					}
+					continue
+				}
+				done[task.UID] = task
+				cli.observeTaskWait(task.UID, start, task, nil)
+				if opts.OnTaskDone != nil {
+					opts.OnTaskDone(task)
+				}
+				if opts.FailFast && (task.Status == TaskStatusFailed || task.Status == TaskStatusCanceled) {
+					return nil, &TaskFailedError{Task: task}
+				}
+			}
+		}
+
+		// A task which is not returned was never enqueued or was deleted, it will never be done
+		var missing []int64
+		for _, uid := range pending {
+			if !returned[uid] {
+				missing = append(missing, uid)
+			}
+		}
+		if len(missing) != 0 {
+			return nil, taskNotFoundError(missing)
+		}
+
+		remaining := pending[:0]
+		for _, uid := range pending {
+			if _, ok := done[uid]; !ok {
+				remaining = append(remaining, uid)
+			}
+		}
+		pending = remaining
+		if len(pending) == 0 {
+			break
+		}
+
+		if interval == 0 {
+			interval = nextPollInterval(strategy, attempt, nil)
+		}
+		if err := sleepContext(ctx, interval); err != nil {
+			return nil, err
+		}
+	}
+
+	tasks := make([]Task, len(uids))
+	for i, uid := range uids {
+		tasks[i] = *done[uid]
+	}
+	return tasks, nil
+}
+
+// taskNotFoundError returns the *Error reporting that the tasks identified by uids are unknown,
+// it matches ErrTaskNotFound like the error of GetTask.
+func taskNotFoundError(uids []int64) error {
+	names := make([]string, len(uids))
+	for i, uid := range uids {
+		names[i] = "`" + strconv.FormatInt(uid, 10) + "`"
+	}
+	message := "Task " + names[0] + " not found."
+	if len(uids) > 1 {
+		message = "Tasks " + strings.Join(names, ", ") + " not found."
+	}
+
+	return (&Error{
+		Endpoint: "/tasks",
+		Method:   http.MethodGet,
+		Function: "WaitForTasks",
+		MeilisearchApiError: APIError{
+			Message: message,
+			Code:    APIErrorCodeTaskNotFound,
+			Type:    "invalid_request",
+			Link:    "https://docs.meilisearch.com/errors#task_not_found",
+		},
+	}).WithErrCode(ErrCodeTaskNotFound)
+}
+
+// uniqueUIDs returns a copy of uids without the duplicates.
+func uniqueUIDs(uids []int64) []int64 {
+	seen := make(map[int64]bool, len(uids))
+	unique := make([]int64, 0, len(uids))
+	for _, uid := range uids {
+		if !seen[uid] {
+			seen[uid] = true
+			unique = append(unique, uid)
+		}
+	}
+	return unique
+}
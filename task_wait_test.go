@@ -0,0 +1,138 @@
+package meilisearch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// tasksTestServer serves the tasks whose uids are queried, a task is processing until it has
+// been polled as many times as its entry in polls. The statuses of the processed tasks are
+// given by statuses, succeeded by default. The missing tasks are never returned.
+type tasksTestServer struct {
+	mu       sync.Mutex
+	polls    map[int64]int
+	statuses map[int64]TaskStatus
+	missing  map[int64]bool
+	queries  []string
+}
+
+func (s *tasksTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queries = append(s.queries, r.URL.RawQuery)
+
+	var results []string
+	for _, raw := range strings.Split(r.URL.Query().Get("uids"), ",") {
+		uid, _ := strconv.ParseInt(raw, 10, 64)
+		if s.missing[uid] {
+			continue
+		}
+		status, ok := s.statuses[uid]
+		if !ok {
+			status = TaskStatusSucceeded
+		}
+		if s.polls[uid] > 0 {
+			s.polls[uid]--
+			status = TaskStatusProcessing
+		}
+		results = append(results, fmt.Sprintf(`{"uid":%d,"status":%q,"type":"documentAdditionOrUpdate"}`, uid, status))
+	}
+	_, _ = fmt.Fprintf(w, `{"results":[%s],"total":%d}`, strings.Join(results, ","), len(results))
+}
+
+func TestWaitForTasks(t *testing.T) {
+	server := &tasksTestServer{
+		polls:    map[int64]int{1: 0, 2: 2, 3: 1},
+		statuses: map[int64]TaskStatus{3: TaskStatusFailed},
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	var doneOrder []int64
+	tasks, err := New(ts.URL).Index("movies").WaitForTasks([]int64{2, 1, 3, 1}, &WaitForTasksOptions{
+		Interval: time.Millisecond,
+		OnTaskDone: func(task *Task) {
+			doneOrder = append(doneOrder, task.UID)
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, tasks, 4)
+	require.Equal(t, []int64{2, 1, 3, 1}, []int64{tasks[0].UID, tasks[1].UID, tasks[2].UID, tasks[3].UID})
+	require.Equal(t, TaskStatusFailed, tasks[2].Status)
+	require.Equal(t, []int64{1, 3, 2}, doneOrder)
+
+	require.Equal(t, []string{
+		"limit=3&uids=2%2C1%2C3",
+		"limit=2&uids=2%2C3",
+		"limit=1&uids=2",
+	}, server.queries)
+}
+
+func TestWaitForTasks_FailFast(t *testing.T) {
+	server := &tasksTestServer{
+		polls:    map[int64]int{1: 5},
+		statuses: map[int64]TaskStatus{2: TaskStatusCanceled},
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	tasks, err := New(ts.URL).WaitForTasks([]int64{1, 2}, &WaitForTasksOptions{
+		Interval: time.Millisecond,
+		FailFast: true,
+	})
+	require.Nil(t, tasks)
+	var failedErr *TaskFailedError
+	require.ErrorAs(t, err, &failedErr)
+	require.Equal(t, int64(2), failedErr.Task.UID)
+	require.Len(t, server.queries, 1)
+}
+
+func TestWaitForTasks_NotFound(t *testing.T) {
+	server := &tasksTestServer{
+		polls:   map[int64]int{1: 1},
+		missing: map[int64]bool{2: true, 4: true},
+	}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	var doneOrder []int64
+	tasks, err := New(ts.URL).WaitForTasks([]int64{1, 2, 3, 4}, &WaitForTasksOptions{
+		Interval: time.Millisecond,
+		OnTaskDone: func(task *Task) {
+			doneOrder = append(doneOrder, task.UID)
+		},
+	})
+	require.Nil(t, tasks)
+	require.ErrorIs(t, err, ErrTaskNotFound)
+	require.True(t, IsNotFound(err))
+
+	var meiliErr *Error
+	require.ErrorAs(t, err, &meiliErr)
+	require.Equal(t, ErrCodeTaskNotFound, meiliErr.ErrCode)
+	require.Equal(t, "Tasks `2`, `4` not found.", meiliErr.MeilisearchApiError.Message)
+
+	// the tasks returned by the same poll are reported before failing
+	require.Equal(t, []int64{3}, doneOrder)
+	require.Len(t, server.queries, 1)
+}
+
+func TestWaitForTasks_Context(t *testing.T) {
+	ts := httptest.NewServer(&tasksTestServer{polls: map[int64]int{1: 1000}})
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := New(ts.URL).WaitForTasksWithContext(ctx, []int64{1}, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,266 @@
+package meilisearch
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultTaskWatcherInterval is the interval between two polls of a TaskWatcher.
+	defaultTaskWatcherInterval = time.Second
+
+	// defaultTaskWatcherLimit is the number of tasks fetched per page while looking for new tasks.
+	defaultTaskWatcherLimit = 100
+)
+
+// TaskEvent is a change of status of a task seen by a TaskWatcher.
+type TaskEvent struct {
+	// Task is the task in its new status.
+	Task Task
+
+	// PreviousStatus is the status the task had when it was last seen, empty the first time the
+	// task is seen.
+	PreviousStatus TaskStatus
+}
+
+// TaskWatcherOptions configures a TaskWatcher.
+type TaskWatcherOptions struct {
+	// IndexUIDS and Types restrict the watched tasks, all the tasks are watched when not set.
+	IndexUIDS []string
+	Types     []TaskType
+
+	// Statuses restricts the events to the tasks reaching one of these statuses, all the events
+	// are sent when not set.
+	Statuses []TaskStatus
+
+	// Since watches the tasks enqueued after it, including the ones enqueued before the watcher
+	// started. When it is not set only the tasks enqueued once the watcher started are watched.
+	Since time.Time
+
+	// Interval between two polls of the tasks, a second by default.
+	Interval time.Duration
+
+	// Limit is the number of tasks fetched per page while looking for new tasks, 100 by default.
+	Limit int64
+
+	// OnEvent receives the events instead of the channel returned by Events. It is called from
+	// the goroutine of the watcher, which waits for it to return.
+	OnEvent func(event TaskEvent)
+}
+
+// TaskWatcher polls the tasks and streams their changes of status, from enqueued to processing
+// and from processing to succeeded, failed or canceled. It is created with WatchTasks and runs
+// until its context is done or Stop is called.
+type TaskWatcher struct {
+	meili    *meilisearch
+	opts     TaskWatcherOptions
+	statuses map[TaskStatus]bool
+
+	// lastUID is the uid of the most recent task seen, -1 when none was seen yet.
+	lastUID int64
+
+	// pending are the statuses of the tasks seen that are not processed yet.
+	pending map[int64]TaskStatus
+
+	events  chan TaskEvent
+	cancel  context.CancelFunc
+	done    chan struct{}
+	stopped int32
+	err     error
+}
+
+func watchTasks(ctx context.Context, cli *client, opts *TaskWatcherOptions) *TaskWatcher {
+	w := &TaskWatcher{
+		meili:   &meilisearch{client: cli},
+		lastUID: -1,
+		pending: make(map[int64]TaskStatus),
+		events:  make(chan TaskEvent, 64),
+		done:    make(chan struct{}),
+	}
+	if opts != nil {
+		w.opts = *opts
+	}
+	if w.opts.Interval <= 0 {
+		w.opts.Interval = defaultTaskWatcherInterval
+	}
+	if w.opts.Limit <= 0 {
+		w.opts.Limit = defaultTaskWatcherLimit
+	}
+	if len(w.opts.Statuses) != 0 {
+		w.statuses = make(map[TaskStatus]bool, len(w.opts.Statuses))
+		for _, status := range w.opts.Statuses {
+			w.statuses[status] = true
+		}
+	}
+
+	ctx, w.cancel = context.WithCancel(ctx)
+	go func() {
+		defer close(w.done)
+		defer close(w.events)
+
+		err := w.run(ctx)
+		if atomic.LoadInt32(&w.stopped) == 0 {
+			w.err = err
+		}
+	}()
+	return w
+}
+
+// Events returns the channel receiving the events, it is closed once the watcher stopped. It
+// receives nothing when TaskWatcherOptions.OnEvent is set.
+func (w *TaskWatcher) Events() <-chan TaskEvent {
+	return w.events
+}
+
+// Err returns the error that stopped the watcher, such as the error of its context or of a tasks
+// query, once the events channel is closed. Nil is returned when the watcher was stopped with
+// Stop.
+func (w *TaskWatcher) Err() error {
+	select {
+	case <-w.done:
+		return w.err
+	default:
+		return nil
+	}
+}
+
+// Stop stops the watcher and waits for it to return.
+func (w *TaskWatcher) Stop() {
+	atomic.StoreInt32(&w.stopped, 1)
+	w.cancel()
+	<-w.done
+}
+
+func (w *TaskWatcher) run(ctx context.Context) error {
+	if w.opts.Since.IsZero() {
+		// only the tasks more recent than the last one are watched
+		res, err := w.meili.GetTasksWithContext(ctx, &TasksQuery{
+			IndexUIDS: w.opts.IndexUIDS,
+			Types:     w.opts.Types,
+			Limit:     1,
+		})
+		if err != nil {
+			return err
+		}
+		if len(res.Results) != 0 {
+			w.lastUID = res.Results[0].UID
+		}
+	}
+
+	for {
+		if err := w.pollPending(ctx); err != nil {
+			return err
+		}
+		if err := w.pollNew(ctx); err != nil {
+			return err
+		}
+		if err := sleepContext(ctx, w.opts.Interval); err != nil {
+			return err
+		}
+	}
+}
+
+// pollPending sends the changes of status of the tasks seen that were not processed yet.
+func (w *TaskWatcher) pollPending(ctx context.Context) error {
+	if len(w.pending) == 0 {
+		return nil
+	}
+
+	uids := make([]int64, 0, len(w.pending))
+	for uid := range w.pending {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+
+	for offset := 0; offset < len(uids); offset += maxTasksQueryUIDs {
+		end := offset + maxTasksQueryUIDs
+		if end > len(uids) {
+			end = len(uids)
+		}
+		chunk := uids[offset:end]
+
+		res, err := w.meili.GetTasksWithContext(ctx, &TasksQuery{
+			UIDS:  chunk,
+			Limit: int64(len(chunk)),
+		})
+		if err != nil {
+			return err
+		}
+
+		seen := make(map[int64]bool, len(res.Results))
+		for i := len(res.Results) - 1; i >= 0; i-- {
+			task := res.Results[i]
+			seen[task.UID] = true
+			if err := w.update(ctx, task); err != nil {
+				return err
+			}
+		}
+		// the tasks deleted in the meantime are not watched anymore
+		for _, uid := range chunk {
+			if !seen[uid] {
+				delete(w.pending, uid)
+			}
+		}
+	}
+	return nil
+}
+
+// pollNew sends the tasks enqueued since the last poll, from the oldest to the most recent. The
+// tasks are listed from the most recent one, page after page, until a task already seen is met.
+func (w *TaskWatcher) pollNew(ctx context.Context) error {
+	query := &TasksQuery{
+		IndexUIDS:       w.opts.IndexUIDS,
+		Types:           w.opts.Types,
+		AfterEnqueuedAt: w.opts.Since,
+		Limit:           w.opts.Limit,
+	}
+
+	var tasks []Task
+	it := newTasksIterator(ctx, w.meili.GetTasksWithContext, query)
+	for it.Next() && it.Task().UID > w.lastUID {
+		tasks = append(tasks, *it.Task())
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+
+	if len(tasks) != 0 {
+		w.lastUID = tasks[0].UID
+	}
+	for i := len(tasks) - 1; i >= 0; i-- {
+		if err := w.update(ctx, tasks[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// update records the status of task and sends an event when it changed.
+func (w *TaskWatcher) update(ctx context.Context, task Task) error {
+	previous := w.pending[task.UID]
+	if task.Status == TaskStatusEnqueued || task.Status == TaskStatusProcessing {
+		w.pending[task.UID] = task.Status
+	} else {
+		delete(w.pending, task.UID)
+	}
+	if task.Status == previous {
+		return nil
+	}
+	if w.statuses != nil && !w.statuses[task.Status] {
+		return nil
+	}
+
+	event := TaskEvent{Task: task, PreviousStatus: previous}
+	if w.opts.OnEvent != nil {
+		w.opts.OnEvent(event)
+		return nil
+	}
+	select {
+	case w.events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
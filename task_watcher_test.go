@@ -0,0 +1,183 @@
+package meilisearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// watchedTasksServer lists its tasks like Meilisearch, from the most recent one, honoring the
+// uids, from and limit query parameters, a failure answers every request when fail is set.
+type watchedTasksServer struct {
+	mu      sync.Mutex
+	tasks   map[int64]TaskStatus
+	queries []string
+	fail    bool
+}
+
+func (s *watchedTasksServer) set(uid int64, status TaskStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tasks[uid] = status
+}
+
+func (s *watchedTasksServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queries = append(s.queries, r.URL.RawQuery)
+	if s.fail {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"internal error","code":"internal","type":"internal","link":""}`))
+		return
+	}
+
+	query := r.URL.Query()
+	var uids []int64
+	if raw := query.Get("uids"); raw != "" {
+		for _, uid := range strings.Split(raw, ",") {
+			parsed, _ := strconv.ParseInt(uid, 10, 64)
+			if _, ok := s.tasks[parsed]; ok {
+				uids = append(uids, parsed)
+			}
+		}
+	} else {
+		for uid := range s.tasks {
+			uids = append(uids, uid)
+		}
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] > uids[j] })
+
+	from, err := strconv.ParseInt(query.Get("from"), 10, 64)
+	if err != nil {
+		from = 1 << 62
+	}
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	res := TaskResult{Results: []Task{}, Limit: int64(limit)}
+	for _, uid := range uids {
+		if uid > from {
+			continue
+		}
+		if len(res.Results) == limit {
+			res.Next = uid
+			break
+		}
+		res.Results = append(res.Results, Task{UID: uid, Status: s.tasks[uid], Type: TaskTypeDocumentAdditionOrUpdate})
+	}
+	body, _ := res.MarshalJSON()
+	_, _ = w.Write(body)
+}
+
+func TestWatchTasks(t *testing.T) {
+	server := &watchedTasksServer{tasks: map[int64]TaskStatus{
+		0: TaskStatusSucceeded,
+		1: TaskStatusSucceeded,
+		2: TaskStatusEnqueued,
+	}}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	watcher := New(ts.URL).WatchTasks(&TaskWatcherOptions{
+		IndexUIDS: []string{"movies"},
+		Since:     since,
+		Interval:  time.Millisecond,
+		Limit:     2,
+	})
+	defer watcher.Stop()
+
+	next := func() TaskEvent {
+		select {
+		case event := <-watcher.Events():
+			return event
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no event received")
+			return TaskEvent{}
+		}
+	}
+
+	for uid := int64(0); uid <= 2; uid++ {
+		event := next()
+		require.Equal(t, uid, event.Task.UID)
+		require.Empty(t, event.PreviousStatus)
+	}
+
+	server.set(2, TaskStatusProcessing)
+	event := next()
+	require.Equal(t, int64(2), event.Task.UID)
+	require.Equal(t, TaskStatusEnqueued, event.PreviousStatus)
+	require.Equal(t, TaskStatusProcessing, event.Task.Status)
+
+	server.set(3, TaskStatusEnqueued)
+	event = next()
+	require.Equal(t, int64(3), event.Task.UID)
+	require.Equal(t, TaskStatusEnqueued, event.Task.Status)
+
+	server.set(2, TaskStatusFailed)
+	event = next()
+	require.Equal(t, int64(2), event.Task.UID)
+	require.Equal(t, TaskStatusProcessing, event.PreviousStatus)
+	require.Equal(t, TaskStatusFailed, event.Task.Status)
+
+	watcher.Stop()
+	_, ok := <-watcher.Events()
+	require.False(t, ok)
+	require.NoError(t, watcher.Err())
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	require.Equal(t, "afterEnqueuedAt=2024-01-01T00%3A00%3A00Z&indexUids=movies&limit=2", server.queries[0])
+	require.Equal(t, "afterEnqueuedAt=2024-01-01T00%3A00%3A00Z&indexUids=movies&limit=2&uids=0", server.queries[1])
+	require.Equal(t, "limit=1&uids=2", server.queries[2])
+}
+
+func TestWatchTasks_OnEvent(t *testing.T) {
+	server := &watchedTasksServer{tasks: map[int64]TaskStatus{
+		0: TaskStatusSucceeded,
+	}}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	events := make(chan TaskEvent)
+	ctx, cancel := context.WithCancel(context.Background())
+	watcher := New(ts.URL).WatchTasksWithContext(ctx, &TaskWatcherOptions{
+		Statuses: []TaskStatus{TaskStatusSucceeded, TaskStatusFailed},
+		Interval: time.Millisecond,
+		OnEvent: func(event TaskEvent) {
+			events <- event
+		},
+	})
+
+	// the tasks enqueued before the watcher started are not watched
+	require.Eventually(t, func() bool {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		return len(server.queries) > 1
+	}, 5*time.Second, time.Millisecond)
+
+	server.set(1, TaskStatusEnqueued)
+	server.set(2, TaskStatusProcessing)
+	server.set(1, TaskStatusSucceeded)
+
+	event := <-events
+	require.Equal(t, int64(1), event.Task.UID)
+	require.Equal(t, TaskStatusSucceeded, event.Task.Status)
+
+	server.set(2, TaskStatusFailed)
+	event = <-events
+	require.Equal(t, int64(2), event.Task.UID)
+	require.Equal(t, TaskStatusProcessing, event.PreviousStatus)
+
+	cancel()
+	_, ok := <-watcher.Events()
+	require.False(t, ok)
+	require.ErrorIs(t, watcher.Err(), context.Canceled)
+}
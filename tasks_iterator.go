@@ -0,0 +1,104 @@
+package meilisearch
+
+import "context"
+
+// TasksIterator walks all the tasks matching a TasksQuery, fetching them page after page by
+// following TaskResult.Next, the most recent task first. The Limit of the query is the size of
+// the pages. Stopping early only requires to stop calling Next.
+//
+//	it := client.TasksIterator(&meilisearch.TasksQuery{IndexUIDS: []string{"movies"}})
+//	for it.Next() {
+//		fmt.Println(it.Task().UID)
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+type TasksIterator struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, param *TasksQuery) (*TaskResult, error)
+	query TasksQuery
+	page  []Task
+	task  *Task
+	last  bool
+	err   error
+}
+
+func newTasksIterator(ctx context.Context, fetch func(context.Context, *TasksQuery) (*TaskResult, error), param *TasksQuery) *TasksIterator {
+	it := &TasksIterator{
+		ctx:   ctx,
+		fetch: fetch,
+	}
+	if param != nil {
+		it.query = *param
+	}
+	return it
+}
+
+// Next advances to the next task, fetching the next page when needed. It returns false once
+// all the tasks were walked or when an error occurred, which is then returned by Err.
+func (it *TasksIterator) Next() bool {
+	it.task = nil
+	for len(it.page) == 0 {
+		if it.last || it.err != nil {
+			return false
+		}
+		if it.err = it.ctx.Err(); it.err != nil {
+			return false
+		}
+
+		// the query is copied as fetching the tasks of an index adds its uid to IndexUIDS
+		query := it.query
+		res, err := it.fetch(it.ctx, &query)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page = res.Results
+		it.last = !it.advance(res)
+	}
+
+	it.task = &it.page[0]
+	it.page = it.page[1:]
+	return true
+}
+
+// Task returns the current task, nil before the first call to Next and once it returned false.
+func (it *TasksIterator) Task() *Task {
+	return it.task
+}
+
+// Err returns the error that stopped the iteration, nil when all the tasks were walked.
+func (it *TasksIterator) Err() error {
+	return it.err
+}
+
+// advance moves the query to the page following res, false is returned when res is the last
+// page.
+func (it *TasksIterator) advance(res *TaskResult) bool {
+	if len(res.Results) == 0 {
+		return false
+	}
+	if res.Next != 0 {
+		it.query.From = res.Next
+		return true
+	}
+
+	// Next is null on the last page, but also zero when the next page starts at the first task,
+	// which a zero From cannot ask for, the first task is then asked for by uid
+	last := res.Results[len(res.Results)-1].UID
+	if last != 1 || int64(len(res.Results)) < res.Limit {
+		return false
+	}
+	if len(it.query.UIDS) != 0 {
+		found := false
+		for _, uid := range it.query.UIDS {
+			found = found || uid == 0
+		}
+		if !found {
+			return false
+		}
+	}
+	it.query.From = 0
+	it.query.UIDS = []int64{0}
+	return true
+}
@@ -0,0 +1,102 @@
+package meilisearch
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTasksIterator(t *testing.T) {
+	server := &watchedTasksServer{tasks: map[int64]TaskStatus{
+		0: TaskStatusSucceeded,
+		1: TaskStatusSucceeded,
+		2: TaskStatusFailed,
+		3: TaskStatusSucceeded,
+		4: TaskStatusProcessing,
+	}}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	it := New(ts.URL).TasksIterator(&TasksQuery{Limit: 2, Types: []TaskType{TaskTypeDocumentAdditionOrUpdate}})
+	require.Nil(t, it.Task())
+
+	var uids []int64
+	for it.Next() {
+		uids = append(uids, it.Task().UID)
+	}
+	require.NoError(t, it.Err())
+	require.Nil(t, it.Task())
+	require.False(t, it.Next())
+
+	require.Equal(t, []int64{4, 3, 2, 1, 0}, uids)
+	require.Equal(t, []string{
+		"limit=2&types=documentAdditionOrUpdate",
+		"from=2&limit=2&types=documentAdditionOrUpdate",
+		"limit=2&types=documentAdditionOrUpdate&uids=0",
+	}, server.queries)
+}
+
+func TestTasksIterator_Index(t *testing.T) {
+	server := &watchedTasksServer{tasks: map[int64]TaskStatus{
+		4: TaskStatusSucceeded,
+		5: TaskStatusSucceeded,
+		6: TaskStatusSucceeded,
+	}}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	// stopping early does not fetch the next pages
+	it := New(ts.URL).Index("movies").TasksIterator(&TasksQuery{Limit: 2})
+	require.True(t, it.Next())
+	require.True(t, it.Next())
+	require.Equal(t, int64(5), it.Task().UID)
+	require.Equal(t, []string{"indexUids=movies&limit=2"}, server.queries)
+
+	require.True(t, it.Next())
+	require.False(t, it.Next())
+	require.NoError(t, it.Err())
+	require.Equal(t, "from=4&indexUids=movies&limit=2", server.queries[1])
+}
+
+func TestTasksIterator_IndexLastPageEndingAtFirstTask(t *testing.T) {
+	server := &watchedTasksServer{tasks: map[int64]TaskStatus{
+		1: TaskStatusSucceeded,
+		2: TaskStatusSucceeded,
+	}}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	it := New(ts.URL).Index("movies").TasksIterator(&TasksQuery{Limit: 1, CanceledBy: []int64{7}})
+	var uids []int64
+	for it.Next() {
+		uids = append(uids, it.Task().UID)
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, []int64{2, 1}, uids)
+	require.Equal(t, []string{
+		"canceledBy=7&indexUids=movies&limit=1",
+		"canceledBy=7&from=1&indexUids=movies&limit=1",
+		"canceledBy=7&indexUids=movies&limit=1&uids=0",
+	}, server.queries)
+}
+
+func TestTasksIterator_Errors(t *testing.T) {
+	server := &watchedTasksServer{tasks: map[int64]TaskStatus{1: TaskStatusSucceeded}, fail: true}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	it := New(ts.URL).TasksIterator(nil)
+	require.False(t, it.Next())
+	require.Error(t, it.Err())
+	var meiliErr *Error
+	require.ErrorAs(t, it.Err(), &meiliErr)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	it = New(ts.URL).TasksIteratorWithContext(ctx, nil)
+	require.False(t, it.Next())
+	require.ErrorIs(t, it.Err(), context.Canceled)
+	require.Len(t, server.queries, 1)
+}
@@ -200,18 +200,19 @@ const (
 //
 // Documentation: https://www.meilisearch.com/docs/learn/advanced/asynchronous_operations
 type Task struct {
-	Status     TaskStatus          `json:"status"`
-	UID        int64               `json:"uid,omitempty"`
-	TaskUID    int64               `json:"taskUid,omitempty"`
-	IndexUID   string              `json:"indexUid"`
-	Type       TaskType            `json:"type"`
-	Error      meilisearchApiError `json:"error,omitempty"`
-	Duration   string              `json:"duration,omitempty"`
-	EnqueuedAt time.Time           `json:"enqueuedAt"`
-	StartedAt  time.Time           `json:"startedAt,omitempty"`
-	FinishedAt time.Time           `json:"finishedAt,omitempty"`
-	Details    Details             `json:"details,omitempty"`
-	CanceledBy int64               `json:"canceledBy,omitempty"`
+	Status     TaskStatus `json:"status"`
+	UID        int64      `json:"uid,omitempty"`
+	TaskUID    int64      `json:"taskUid,omitempty"`
+	IndexUID   string     `json:"indexUid"`
+	Type       TaskType   `json:"type"`
+	Error      APIError   `json:"error,omitempty"`
+	Duration   string     `json:"duration,omitempty"`
+	EnqueuedAt time.Time  `json:"enqueuedAt"`
+	StartedAt  time.Time  `json:"startedAt,omitempty"`
+	FinishedAt time.Time  `json:"finishedAt,omitempty"`
+	Details    Details    `json:"details,omitempty"`
+	CanceledBy int64      `json:"canceledBy,omitempty"`
+	BatchUID   *int64     `json:"batchUid,omitempty"`
 }
 
 // TaskInfo indicates information regarding a task returned by an asynchronous method
@@ -269,6 +270,24 @@ type DeleteTasksQuery struct {
 	AfterFinishedAt  time.Time
 }
 
+// BatchesQuery is a list of filter available to send as query parameters, UIDS are the uids of
+// the batches
+type BatchesQuery struct {
+	UIDS             []int64
+	Limit            int64
+	From             int64
+	IndexUIDS        []string
+	Statuses         []TaskStatus
+	Types            []TaskType
+	CanceledBy       []int64
+	BeforeEnqueuedAt time.Time
+	AfterEnqueuedAt  time.Time
+	BeforeStartedAt  time.Time
+	AfterStartedAt   time.Time
+	BeforeFinishedAt time.Time
+	AfterFinishedAt  time.Time
+}
+
 type Details struct {
 	ReceivedDocuments    int64               `json:"receivedDocuments,omitempty"`
 	IndexedDocuments     int64               `json:"indexedDocuments,omitempty"`
@@ -303,6 +322,52 @@ type TaskResult struct {
 	Total   int64  `json:"total"`
 }
 
+// Batch is a group of tasks processed together by Meilisearch
+//
+// Documentation: https://www.meilisearch.com/docs/reference/api/batches
+type Batch struct {
+	UID           int64          `json:"uid"`
+	Progress      *BatchProgress `json:"progress,omitempty"`
+	Details       Details        `json:"details,omitempty"`
+	Stats         BatchStats     `json:"stats"`
+	Duration      string         `json:"duration,omitempty"`
+	StartedAt     time.Time      `json:"startedAt,omitempty"`
+	FinishedAt    time.Time      `json:"finishedAt,omitempty"`
+	BatchStrategy string         `json:"batchStrategy,omitempty"`
+}
+
+// BatchProgress is the progress of a batch being processed, nil once it is processed
+type BatchProgress struct {
+	Steps      []BatchProgressStep `json:"steps"`
+	Percentage float64             `json:"percentage"`
+}
+
+// BatchProgressStep is a step of the processing of a batch, the steps are nested in order
+type BatchProgressStep struct {
+	CurrentStep string `json:"currentStep"`
+	Finished    int64  `json:"finished"`
+	Total       int64  `json:"total"`
+}
+
+// BatchStats counts the tasks of a batch, ProgressTrace is the time spent in each step of the
+// processing
+type BatchStats struct {
+	TotalNbTasks  int64                `json:"totalNbTasks"`
+	Status        map[TaskStatus]int64 `json:"status"`
+	Types         map[TaskType]int64   `json:"types"`
+	IndexUIDs     map[string]int64     `json:"indexUids"`
+	ProgressTrace map[string]string    `json:"progressTrace,omitempty"`
+}
+
+// BatchResult return of multiple batches is wrap in a BatchResult
+type BatchResult struct {
+	Results []Batch `json:"results"`
+	Limit   int64   `json:"limit"`
+	From    int64   `json:"from"`
+	Next    int64   `json:"next"`
+	Total   int64   `json:"total"`
+}
+
 // Key allow the user to connect to the meilisearch instance
 //
 // Documentation: https://www.meilisearch.com/docs/learn/security/master_api_keys#protecting-a-meilisearch-instance
@@ -444,6 +509,40 @@ type SearchResponse struct {
 	IndexUID           string        `json:"indexUid,omitempty"`
 }
 
+// SearchHitMetadata are the fields added by Meilisearch to a hit, according to the Show options,
+// the AttributesToHighlight and AttributesToCrop and the geo sort of the SearchRequest. The
+// GeoDistance is in meters
+type SearchHitMetadata struct {
+	Formatted           map[string]interface{}        `json:"_formatted,omitempty"`
+	MatchesPosition     map[string][]MatchPosition    `json:"_matchesPosition,omitempty"`
+	RankingScore        float64                       `json:"_rankingScore,omitempty"`
+	RankingScoreDetails map[string]RankingScoreDetail `json:"_rankingScoreDetails,omitempty"`
+	GeoDistance         float64                       `json:"_geoDistance,omitempty"`
+}
+
+// MatchPosition is the position of a match in an attribute of a hit, in bytes
+type MatchPosition struct {
+	Start   int64   `json:"start"`
+	Length  int64   `json:"length"`
+	Indices []int64 `json:"indices,omitempty"`
+}
+
+// RankingScoreDetail is the part of the ranking score of a hit given by a ranking rule, only
+// the fields of the rule are set
+type RankingScoreDetail struct {
+	Order                      int64       `json:"order"`
+	Score                      float64     `json:"score,omitempty"`
+	MatchingWords              int64       `json:"matchingWords,omitempty"`
+	MaxMatchingWords           int64       `json:"maxMatchingWords,omitempty"`
+	TypoCount                  int64       `json:"typoCount,omitempty"`
+	MaxTypoCount               int64       `json:"maxTypoCount,omitempty"`
+	AttributeRankingOrderScore float64     `json:"attributeRankingOrderScore,omitempty"`
+	QueryWordDistanceScore     float64     `json:"queryWordDistanceScore,omitempty"`
+	MatchType                  string      `json:"matchType,omitempty"`
+	Value                      interface{} `json:"value,omitempty"`
+	Distance                   float64     `json:"distance,omitempty"`
+}
+
 type MultiSearchResponse struct {
 	Results            []SearchResponse `json:"results,omitempty"`
 	Hits               []interface{}    `json:"hits,omitempty"`
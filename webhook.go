@@ -0,0 +1,215 @@
+package meilisearch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// defaultWebhookMaxBodySize is the default size limit of a task webhook request body.
+	defaultWebhookMaxBodySize = 16 << 20
+
+	// defaultWebhookMaxPayloadSize is the default size limit of a decompressed task webhook
+	// payload.
+	defaultWebhookMaxPayloadSize = 64 << 20
+
+	// defaultWebhookMaxLineSize is the default size limit of a task in a task webhook payload.
+	defaultWebhookMaxLineSize = 1 << 20
+)
+
+// errPayloadTooLarge is returned by a limitedReader once its limit is exceeded.
+var errPayloadTooLarge = errors.New("payload too large")
+
+// TaskWebhookFunc receives every task posted to a task webhook handler, returning an error
+// answers the request with the status code 500. The error is not sent back to Meilisearch.
+type TaskWebhookFunc func(ctx context.Context, task *Task) error
+
+// TaskWebhookOptions configures the handler created by NewTaskWebhookHandler.
+type TaskWebhookOptions struct {
+	// Authorization is the value expected in the authorization header, the one given to
+	// Meilisearch with --task-webhook-authorization-header. All the requests are rejected when
+	// it is empty, unless AllowUnauthenticated is set.
+	Authorization string
+
+	// AuthorizationHeader is the header carrying Authorization, Authorization by default.
+	AuthorizationHeader string
+
+	// AllowUnauthenticated accepts the requests without checking their authorization when
+	// Authorization is empty, e.g. when the handler is only reachable from Meilisearch.
+	AllowUnauthenticated bool
+
+	// MaxBodySize caps the size of the request body as received, 16 MiB by default.
+	MaxBodySize int64
+
+	// MaxPayloadSize caps the size of the payload once decompressed, 64 MiB by default.
+	MaxPayloadSize int64
+
+	// MaxLineSize caps the size of a single task of the payload, 1 MiB by default.
+	MaxLineSize int
+}
+
+// NewTaskWebhookHandler returns an http.Handler receiving the tasks that Meilisearch posts to
+// its --task-webhook-url once they are processed, calling fn for each of them in order. The
+// payload is decompressed according to its Content-Encoding and decoded line by line as
+// NDJSON.
+//
+// The handler answers 405 to a method other than POST, 401 when the authorization does not
+// match, 415 to an unknown Content-Encoding, 413 when a size limit is exceeded, 400 to a
+// malformed payload and 500 when fn failed. The tasks decoded before a malformed line are passed
+// to fn.
+func NewTaskWebhookHandler(fn TaskWebhookFunc, opts *TaskWebhookOptions) http.Handler {
+	h := &taskWebhookHandler{
+		fn:             fn,
+		header:         "Authorization",
+		maxBodySize:    defaultWebhookMaxBodySize,
+		maxPayloadSize: defaultWebhookMaxPayloadSize,
+		maxLineSize:    defaultWebhookMaxLineSize,
+	}
+	if opts != nil {
+		h.authorization = opts.Authorization
+		h.allowUnauthenticated = opts.AllowUnauthenticated
+		if opts.AuthorizationHeader != "" {
+			h.header = opts.AuthorizationHeader
+		}
+		if opts.MaxBodySize > 0 {
+			h.maxBodySize = opts.MaxBodySize
+		}
+		if opts.MaxPayloadSize > 0 {
+			h.maxPayloadSize = opts.MaxPayloadSize
+		}
+		if opts.MaxLineSize > 0 {
+			h.maxLineSize = opts.MaxLineSize
+		}
+	}
+	return h
+}
+
+type taskWebhookHandler struct {
+	fn                   TaskWebhookFunc
+	header               string
+	authorization        string
+	allowUnauthenticated bool
+	maxBodySize          int64
+	maxPayloadSize       int64
+	maxLineSize          int
+}
+
+func (h *taskWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.authorized(r) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	// The limit of MaxBytesReader is one byte above the one of limitedReader, which reports it
+	raw := &limitedReader{
+		reader:    http.MaxBytesReader(w, r.Body, h.maxBodySize+1),
+		remaining: h.maxBodySize,
+	}
+	encoding := ContentEncoding(strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding"))))
+	body, err := encoding.newReader(raw)
+	if err == ErrInvalidContentEncoding {
+		http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+		return
+	}
+	if errors.Is(err, errPayloadTooLarge) {
+		http.Error(w, errPayloadTooLarge.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		http.Error(w, "invalid compressed payload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer body.Close()
+
+	payload := &limitedReader{reader: body, remaining: h.maxPayloadSize}
+	if status, err := h.dispatch(r.Context(), payload); err != nil {
+		if status == http.StatusInternalServerError {
+			// The error of fn is not meant for Meilisearch
+			http.Error(w, http.StatusText(status), status)
+			return
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// authorized reports whether r carries the expected authorization.
+func (h *taskWebhookHandler) authorized(r *http.Request) bool {
+	if h.authorization == "" {
+		return h.allowUnauthenticated
+	}
+	return subtle.ConstantTimeCompare([]byte(r.Header.Get(h.header)), []byte(h.authorization)) == 1
+}
+
+// dispatch decodes the tasks of the NDJSON payload and passes them to fn, the status code
+// answering the request is returned with the error.
+func (h *taskWebhookHandler) dispatch(ctx context.Context, body io.Reader) (int, error) {
+	scanner := bufio.NewScanner(body)
+	initial := 64 << 10
+	if initial > h.maxLineSize {
+		initial = h.maxLineSize
+	}
+	scanner.Buffer(make([]byte, 0, initial), h.maxLineSize)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		task := new(Task)
+		if err := json.Unmarshal(line, task); err != nil {
+			return http.StatusBadRequest, err
+		}
+		if err := h.fn(ctx, task); err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
+
+	err := scanner.Err()
+	switch {
+	case err == nil:
+		return http.StatusOK, nil
+	case errors.Is(err, errPayloadTooLarge):
+		return http.StatusRequestEntityTooLarge, errPayloadTooLarge
+	case errors.Is(err, bufio.ErrTooLong):
+		return http.StatusRequestEntityTooLarge, errors.New("task too large")
+	default:
+		return http.StatusBadRequest, err
+	}
+}
+
+// limitedReader reads from reader until more than remaining bytes are read, failing then with
+// errPayloadTooLarge.
+type limitedReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if r.remaining < 0 {
+		return 0, errPayloadTooLarge
+	}
+	// Reading one more byte than allowed tells an exceeded limit from an exact one
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+	n, err := r.reader.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n - 1, errPayloadTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,204 @@
+package meilisearch
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// taskWebhookPayload is a payload posted by Meilisearch to its task webhook.
+const taskWebhookPayload = `{"uid":4,"batchUid":4,"indexUid":"movies","status":"succeeded","type":"documentAdditionOrUpdate","canceledBy":null,"details":{"receivedDocuments":31944,"indexedDocuments":31944},"error":null,"duration":"PT2.043S","enqueuedAt":"2024-08-08T09:52:11.215593Z","startedAt":"2024-08-08T09:52:11.233247Z","finishedAt":"2024-08-08T09:52:13.276247Z"}
+{"uid":5,"batchUid":5,"indexUid":"books","status":"failed","type":"indexCreation","canceledBy":null,"details":{"primaryKey":null},"error":{"message":"Index books already exists.","code":"index_already_exists","type":"invalid_request","link":"https://docs.meilisearch.com/errors#index_already_exists"},"duration":"PT0.005S","enqueuedAt":"2024-08-08T09:52:14.211203Z","startedAt":"2024-08-08T09:52:14.218291Z","finishedAt":"2024-08-08T09:52:14.223291Z"}
+`
+
+func gzipped(t *testing.T, payload string) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	w := gzip.NewWriter(buf)
+	_, err := w.Write([]byte(payload))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf
+}
+
+func TestTaskWebhookHandler(t *testing.T) {
+	var tasks []*Task
+	handler := NewTaskWebhookHandler(func(_ context.Context, task *Task) error {
+		tasks = append(tasks, task)
+		return nil
+	}, &TaskWebhookOptions{Authorization: "Bearer secret"})
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	req, err := http.NewRequest(http.MethodPost, ts.URL, gzipped(t, taskWebhookPayload))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret")
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.Len(t, tasks, 2)
+	require.Equal(t, int64(4), tasks[0].UID)
+	require.Equal(t, TaskStatusSucceeded, tasks[0].Status)
+	details, ok := tasks[0].DocumentAdditionDetails()
+	require.True(t, ok)
+	require.Equal(t, int64(31944), details.IndexedDocuments)
+
+	require.Equal(t, "books", tasks[1].IndexUID)
+	require.ErrorIs(t, tasks[1].Err(), ErrIndexAlreadyExists)
+}
+
+func TestTaskWebhookHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		header  http.Header
+		body    func(t *testing.T) *bytes.Buffer
+		opts    func(opts *TaskWebhookOptions)
+		fn      TaskWebhookFunc
+		status  int
+		handled int
+	}{
+		{
+			name:   "Method not allowed",
+			method: http.MethodGet,
+			header: http.Header{"X-Webhook-Token": {"secret"}},
+			status: http.StatusMethodNotAllowed,
+		},
+		{
+			name:   "Missing authorization",
+			header: http.Header{},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "No authorization configured",
+			header: http.Header{},
+			opts:   func(opts *TaskWebhookOptions) { opts.Authorization = "" },
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "Unauthenticated allowed",
+			header: http.Header{},
+			body: func(t *testing.T) *bytes.Buffer {
+				return bytes.NewBufferString(taskWebhookPayload)
+			},
+			opts: func(opts *TaskWebhookOptions) {
+				opts.Authorization = ""
+				opts.AllowUnauthenticated = true
+			},
+			status:  http.StatusOK,
+			handled: 2,
+		},
+		{
+			name:   "Wrong authorization",
+			header: http.Header{"X-Webhook-Token": {"wrong"}},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "Unsupported encoding",
+			header: http.Header{"X-Webhook-Token": {"secret"}, "Content-Encoding": {"zstd"}},
+			status: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:   "Invalid gzip payload",
+			header: http.Header{"X-Webhook-Token": {"secret"}, "Content-Encoding": {"gzip"}},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "Malformed line",
+			header: http.Header{"X-Webhook-Token": {"secret"}},
+			body: func(t *testing.T) *bytes.Buffer {
+				return bytes.NewBufferString(strings.SplitAfter(taskWebhookPayload, "\n")[0] + "{\"uid\":\n")
+			},
+			status:  http.StatusBadRequest,
+			handled: 1,
+		},
+		{
+			name:   "Body too large",
+			header: http.Header{"X-Webhook-Token": {"secret"}, "Content-Encoding": {"gzip"}},
+			body: func(t *testing.T) *bytes.Buffer {
+				return gzipped(t, taskWebhookPayload)
+			},
+			opts:   func(opts *TaskWebhookOptions) { opts.MaxBodySize = 16 },
+			status: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:   "Decompressed payload too large",
+			header: http.Header{"X-Webhook-Token": {"secret"}, "Content-Encoding": {"gzip"}},
+			body: func(t *testing.T) *bytes.Buffer {
+				return gzipped(t, taskWebhookPayload+strings.Repeat("\n", 1<<20))
+			},
+			opts:    func(opts *TaskWebhookOptions) { opts.MaxPayloadSize = int64(len(taskWebhookPayload)) + 1024 },
+			status:  http.StatusRequestEntityTooLarge,
+			handled: 2,
+		},
+		{
+			name:   "Task too large",
+			header: http.Header{"X-Webhook-Token": {"secret"}, "Content-Encoding": {"gzip"}},
+			body: func(t *testing.T) *bytes.Buffer {
+				return gzipped(t, strings.Repeat(" ", 1<<20))
+			},
+			opts:   func(opts *TaskWebhookOptions) { opts.MaxLineSize = 1024 },
+			status: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:   "Callback error",
+			header: http.Header{"X-Webhook-Token": {"secret"}, "Content-Encoding": {"gzip"}},
+			body: func(t *testing.T) *bytes.Buffer {
+				return gzipped(t, taskWebhookPayload)
+			},
+			fn: func(context.Context, *Task) error {
+				return errors.New("queue is full")
+			},
+			status:  http.StatusInternalServerError,
+			handled: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &TaskWebhookOptions{
+				Authorization:       "secret",
+				AuthorizationHeader: "X-Webhook-Token",
+			}
+			if tt.opts != nil {
+				tt.opts(opts)
+			}
+			handled := 0
+			handler := NewTaskWebhookHandler(func(ctx context.Context, task *Task) error {
+				handled++
+				if tt.fn != nil {
+					return tt.fn(ctx, task)
+				}
+				return nil
+			}, opts)
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			body := bytes.NewBufferString("not compressed")
+			if tt.body != nil {
+				body = tt.body(t)
+			}
+			req := httptest.NewRequest(method, "/webhook", body)
+			req.Header = tt.header
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.status, rec.Code)
+			require.Equal(t, tt.handled, handled)
+			// the errors of the callback are not sent back
+			require.NotContains(t, rec.Body.String(), "queue is full")
+		})
+	}
+}